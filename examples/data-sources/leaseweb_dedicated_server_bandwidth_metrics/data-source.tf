@@ -0,0 +1,23 @@
+# Hourly average bandwidth of a dedicated server
+data "leaseweb_dedicated_server_bandwidth_metrics" "example" {
+  dedicated_server_id = "12345"
+  from                = "2024-06-01T00:00:00Z"
+  to                  = "2024-06-02T00:00:00Z"
+  aggregation         = "AVG"
+  granularity         = "HOUR"
+}
+
+# Compare the 95th percentile against the bandwidth notification threshold
+data "leaseweb_dedicated_server_bandwidth_metrics" "percentile" {
+  dedicated_server_id = "12345"
+  from                = "2024-06-01T00:00:00Z"
+  to                  = "2024-07-01T00:00:00Z"
+  aggregation         = "95TH"
+}
+
+check "bandwidth" {
+  assert {
+    condition     = data.leaseweb_dedicated_server_bandwidth_metrics.percentile.down_public.values[0].value < 1000000000
+    error_message = "The 95th percentile of incoming traffic exceeds 1 Gbps."
+  }
+}
@@ -0,0 +1,14 @@
+# List the credential types and usernames of a dedicated server
+data "leaseweb_dedicated_server_credentials" "audit" {
+  dedicated_server_id = "12345"
+}
+
+output "credential_usernames" {
+  value = [for credential in data.leaseweb_dedicated_server_credentials.audit.credentials : "${credential.type}/${credential.username}"]
+}
+
+# Include the passwords, which are marked sensitive
+data "leaseweb_dedicated_server_credentials" "with_passwords" {
+  dedicated_server_id = "12345"
+  include_passwords   = true
+}
@@ -0,0 +1,13 @@
+# Daily datatraffic of a dedicated server
+data "leaseweb_dedicated_server_datatraffic_metrics" "example" {
+  dedicated_server_id = "12345"
+  from                = "2024-06-01T00:00:00Z"
+  to                  = "2024-07-01T00:00:00Z"
+  aggregation         = "SUM"
+  granularity         = "DAY"
+}
+
+# Total outgoing datatraffic over the interval, in bytes
+output "total_up_public" {
+  value = sum(data.leaseweb_dedicated_server_datatraffic_metrics.example.up_public.values[*].value)
+}
@@ -0,0 +1,4 @@
+# Hardware of dedicated server
+data "leaseweb_dedicated_server_hardware" "example" {
+  dedicated_server_id = "12345"
+}
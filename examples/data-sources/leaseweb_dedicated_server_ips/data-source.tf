@@ -0,0 +1,8 @@
+# List the IPs of a dedicated server
+data "leaseweb_dedicated_server_ips" "example" {
+  dedicated_server_id = "12345"
+}
+
+output "null_routed_ips" {
+  value = [for ip in data.leaseweb_dedicated_server_ips.example.ips : ip.ip if ip.null_routed]
+}
@@ -0,0 +1,5 @@
+# Status of a dedicated server job
+data "leaseweb_dedicated_server_job" "example" {
+  dedicated_server_id = "12345"
+  id                  = "bcf2bedf-8450-4b22-86a8-f30aeb3a38f9"
+}
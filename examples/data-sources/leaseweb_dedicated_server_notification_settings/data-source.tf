@@ -0,0 +1,4 @@
+# Notification settings of dedicated server
+data "leaseweb_dedicated_server_notification_settings" "example" {
+  dedicated_server_id = "12345"
+}
@@ -0,0 +1,18 @@
+# Retrieve the installation defaults of an operating system
+data "leaseweb_dedicated_server_operating_system" "ubuntu" {
+  operating_system_id = "UBUNTU_22_04_64BIT"
+}
+
+# Install the operating system with its default partitions and a larger /tmp
+resource "leaseweb_dedicated_server_installation" "example" {
+  dedicated_server_id = "12345"
+  operating_system_id = data.leaseweb_dedicated_server_operating_system.ubuntu.operating_system_id
+  device              = data.leaseweb_dedicated_server_operating_system.ubuntu.defaults.device
+  partitions = [
+    for partition in data.leaseweb_dedicated_server_operating_system.ubuntu.defaults.partitions : {
+      filesystem = partition.filesystem
+      mountpoint = partition.mountpoint
+      size       = partition.mountpoint == "/tmp" ? "8192" : partition.size
+    }
+  ]
+}
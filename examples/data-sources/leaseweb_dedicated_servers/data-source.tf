@@ -1,3 +1,15 @@
 # List all Dedicated servers
 data "leaseweb_dedicated_servers" "all" {
 }
+
+# List the active web servers in shared racks, including their power state
+data "leaseweb_dedicated_servers" "web" {
+  reference_regex     = "^web\\."
+  rack_type           = "SHARED"
+  contract_status     = "ACTIVE"
+  include_power_state = true
+}
+
+output "web_server_public_ips" {
+  value = { for server in data.leaseweb_dedicated_servers.web.servers : server.id => server.public_ip }
+}
@@ -0,0 +1,11 @@
+# Example install operating system on dedicated server with a partition layout
+resource "leaseweb_dedicated_server_installation" "example" {
+  dedicated_server_id = "12345"
+  operating_system_id = "UBUNTU_22_04_64BIT"
+  partitions = provider::leaseweb::partition_layout({
+    "/boot" = "1G"
+    swap    = "4G"
+    "/tmp"  = "xfs:10G"
+    "/"     = "*"
+  })
+}
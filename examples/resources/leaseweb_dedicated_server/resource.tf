@@ -1,4 +1,6 @@
+# Adopt an existing server by its ID
 resource "leaseweb_dedicated_server" "web01" {
+  server_id                       = "12345"
   reference                       = "web01"
   reverse_lookup                  = "web01.example.com"
   dhcp_lease                      = "https://boot.netboot.xyz"
@@ -6,3 +8,24 @@ resource "leaseweb_dedicated_server" "web01" {
   public_network_interface_opened = true
   public_ip_null_routed           = false
 }
+
+# Leave a decommissioned server in a safe state when the resource is destroyed
+resource "leaseweb_dedicated_server" "web02" {
+  server_id = "67890"
+  reference = "web02"
+
+  on_destroy = {
+    reset_reference                = true
+    power_off                      = true
+    close_public_network_interface = true
+    remove_dhcp_reservation        = true
+    null_route_public_ip           = true
+  }
+}
+
+# Adopt an existing server by its asset ID, without an import block
+resource "leaseweb_dedicated_server" "db01" {
+  asset_id   = "627294"
+  reference  = "db01"
+  powered_on = true
+}
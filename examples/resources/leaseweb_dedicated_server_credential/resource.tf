@@ -5,3 +5,23 @@ resource "leaseweb_dedicated_server_credential" "example" {
   type                = "OPERATING_SYSTEM"
   password            = "mys3cr3tp@ssw0rd"
 }
+
+# Generate the password and rotate it every 90 days
+resource "time_rotating" "root_password" {
+  rotation_days = 90
+}
+
+resource "leaseweb_dedicated_server_credential" "generated" {
+  dedicated_server_id = "12345"
+  username            = "root"
+  type                = "REMOTE_MANAGEMENT"
+
+  password_policy = {
+    length  = 24
+    special = false
+  }
+
+  keepers = {
+    rotation = time_rotating.root_password.id
+  }
+}
@@ -0,0 +1,2 @@
+# Dedicated server DHCP reservation can be imported by passing "dedicated_server_id".
+terraform import leaseweb_dedicated_server_dhcp_reservation.example 12345
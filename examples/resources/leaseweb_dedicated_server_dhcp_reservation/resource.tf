@@ -0,0 +1,6 @@
+# Example network boot a dedicated server from an iPXE script
+resource "leaseweb_dedicated_server_dhcp_reservation" "pxe" {
+  dedicated_server_id = "12345"
+  bootfile            = "http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe"
+  hostname            = "my-server"
+}
@@ -0,0 +1,7 @@
+# Example run a hardware scan on dedicated server
+resource "leaseweb_dedicated_server_hardware_scan" "example" {
+  dedicated_server_id = "12345"
+  triggers = {
+    scanned_for = "2025-Q1"
+  }
+}
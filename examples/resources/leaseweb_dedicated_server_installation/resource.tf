@@ -12,4 +12,16 @@ resource "leaseweb_dedicated_server_installation" "example" {
       #!/bin/sh
       apt install nginx -y -qq
   EOS
-}
\ No newline at end of file
+}
+
+# Example install operating system on dedicated server without waiting for the installation to finish
+resource "leaseweb_dedicated_server_installation" "example" {
+  dedicated_server_id = "12345"
+  operating_system_id = "UBUNTU_22_04_64BIT"
+  wait_for_completion = false
+}
+
+data "leaseweb_dedicated_server_job" "example" {
+  dedicated_server_id = leaseweb_dedicated_server_installation.example.dedicated_server_id
+  id                  = leaseweb_dedicated_server_installation.example.id
+}
@@ -0,0 +1,2 @@
+# Dedicated server IP can be imported by passing "server_id,ip".
+terraform import leaseweb_dedicated_server_ip.example 12345,12.123.123.2
@@ -0,0 +1,22 @@
+# Set the reverse lookup of an additional IP of a dedicated server
+resource "leaseweb_dedicated_server_ip" "example" {
+  dedicated_server_id = "12345"
+  ip                  = "12.123.123.2"
+  reverse_lookup      = "mail.example.com"
+}
+
+# Null route every public IP of a dedicated server except the main IP
+data "leaseweb_dedicated_server_ips" "example" {
+  dedicated_server_id = "12345"
+}
+
+resource "leaseweb_dedicated_server_ip" "null_routed" {
+  for_each = {
+    for ip in data.leaseweb_dedicated_server_ips.example.ips : ip.ip => ip
+    if ip.network_type == "PUBLIC" && !ip.main_ip
+  }
+
+  dedicated_server_id = "12345"
+  ip                  = each.key
+  null_routed         = true
+}
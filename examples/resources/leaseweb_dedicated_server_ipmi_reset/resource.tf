@@ -0,0 +1,7 @@
+# Example reset the IPMI interface of a dedicated server
+resource "leaseweb_dedicated_server_ipmi_reset" "example" {
+  dedicated_server_id = "12345"
+  triggers = {
+    reset_for = "2025-Q1"
+  }
+}
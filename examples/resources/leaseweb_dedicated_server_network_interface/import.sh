@@ -0,0 +1,2 @@
+# Dedicated server network interface can be imported by passing "dedicated_server_id,type".
+terraform import leaseweb_dedicated_server_network_interface.example 12345,PUBLIC
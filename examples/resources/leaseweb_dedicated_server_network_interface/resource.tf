@@ -0,0 +1,6 @@
+# Example close the public network interface of dedicated server
+resource "leaseweb_dedicated_server_network_interface" "public" {
+  dedicated_server_id = "12345"
+  type                = "PUBLIC"
+  opened              = false
+}
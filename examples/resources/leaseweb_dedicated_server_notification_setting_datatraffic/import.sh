@@ -0,0 +1,2 @@
+# Dedicated server datatraffic notification setting can be imported by passing "server_id,notification_setting_id".
+terraform import leaseweb_dedicated_server_notification_setting_datatraffic.example 12345,67890
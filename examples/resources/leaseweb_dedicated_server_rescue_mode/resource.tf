@@ -0,0 +1,10 @@
+# Example launch rescue mode on dedicated server
+resource "leaseweb_dedicated_server_rescue_mode" "example" {
+  dedicated_server_id = "12345"
+  rescue_image_id     = "GRML"
+  ssh_keys            = ["ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQDA"]
+  post_install_script = <<-EOS
+      #!/bin/sh
+      smartctl -a /dev/sda
+  EOS
+}
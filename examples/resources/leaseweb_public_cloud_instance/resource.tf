@@ -15,3 +15,39 @@ resource "leaseweb_public_cloud_instance" "example" {
   type                   = "lsw.m3.large"
   private_network        = true
 }
+
+# Keep a development instance stopped
+resource "leaseweb_public_cloud_instance" "development" {
+  contract = {
+    billing_frequency = 1
+    term              = 0
+    type              = "HOURLY"
+  }
+  image = {
+    id = "UBUNTU_22_04_64BIT"
+  }
+  reference              = "my development server"
+  region                 = "eu-west-3"
+  root_disk_storage_type = "CENTRAL"
+  root_disk_size         = 5
+  type                   = "lsw.m3.large"
+  desired_state          = "STOPPED"
+}
+
+# Reinstall the instance in place when the image changes
+resource "leaseweb_public_cloud_instance" "reinstallable" {
+  contract = {
+    billing_frequency = 1
+    term              = 0
+    type              = "HOURLY"
+  }
+  image = {
+    id = "UBUNTU_24_04_64BIT"
+  }
+  reference                 = "my reinstallable server"
+  region                    = "eu-west-3"
+  root_disk_storage_type    = "CENTRAL"
+  root_disk_size            = 5
+  type                      = "lsw.m3.large"
+  reinstall_on_image_change = true
+}
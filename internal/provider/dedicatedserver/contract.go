@@ -0,0 +1,76 @@
+package dedicatedserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+// contractModel is the contract of a dedicated server, shared by the
+// dedicated server data sources and resource.
+type contractModel struct {
+	ID                types.String `tfsdk:"id"`
+	AggregationPackID types.String `tfsdk:"aggregation_pack_id"`
+	BillingCycle      types.Int32  `tfsdk:"billing_cycle"`
+	BillingFrequency  types.String `tfsdk:"billing_frequency"`
+	ContractTerm      types.Int32  `tfsdk:"contract_term"`
+	ContractType      types.String `tfsdk:"contract_type"`
+	Currency          types.String `tfsdk:"currency"`
+	EndsAt            types.String `tfsdk:"ends_at"`
+	PricePerFrequency types.String `tfsdk:"price_per_frequency"`
+	SLA               types.String `tfsdk:"sla"`
+	StartsAt          types.String `tfsdk:"starts_at"`
+	Status            types.String `tfsdk:"status"`
+}
+
+func (c contractModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                  types.StringType,
+		"aggregation_pack_id": types.StringType,
+		"billing_cycle":       types.Int32Type,
+		"billing_frequency":   types.StringType,
+		"contract_term":       types.Int32Type,
+		"contract_type":       types.StringType,
+		"currency":            types.StringType,
+		"ends_at":             types.StringType,
+		"price_per_frequency": types.StringType,
+		"sla":                 types.StringType,
+		"starts_at":           types.StringType,
+		"status":              types.StringType,
+	}
+}
+
+func adaptContractToContractModel(contract *dedicatedserver.Contract) *contractModel {
+	if contract == nil {
+		return nil
+	}
+
+	id, _ := contract.GetIdOk()
+	aggregationPackID, _ := contract.GetAggregationPackIdOk()
+	billingCycle, _ := contract.GetBillingCycleOk()
+	billingFrequency, _ := contract.GetBillingFrequencyOk()
+	contractTerm, _ := contract.GetContractTermOk()
+	contractType, _ := contract.GetContractTypeOk()
+	currency, _ := contract.GetCurrencyOk()
+	endsAt, _ := contract.GetEndsAtOk()
+	pricePerFrequency, _ := contract.GetPricePerFrequencyOk()
+	sla, _ := contract.GetSlaOk()
+	startsAt, _ := contract.GetStartsAtOk()
+	status, _ := contract.GetStatusOk()
+
+	return &contractModel{
+		ID:                types.StringPointerValue(id),
+		AggregationPackID: types.StringPointerValue(aggregationPackID),
+		BillingCycle:      types.Int32PointerValue(billingCycle),
+		BillingFrequency:  types.StringPointerValue(billingFrequency),
+		ContractTerm:      types.Int32PointerValue(contractTerm),
+		ContractType:      types.StringPointerValue(contractType),
+		Currency:          types.StringPointerValue(currency),
+		EndsAt:            utils.AdaptNullableTimeToStringValue(endsAt),
+		PricePerFrequency: types.StringPointerValue(pricePerFrequency),
+		SLA:               types.StringPointerValue(sla),
+		StartsAt:          utils.AdaptNullableTimeToStringValue(startsAt),
+		Status:            types.StringPointerValue(status),
+	}
+}
@@ -0,0 +1,49 @@
+package dedicatedserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptContractToContractModel(t *testing.T) {
+	t.Run("returns nil without contract", func(t *testing.T) {
+		assert.Nil(t, adaptContractToContractModel(nil))
+	})
+
+	t.Run("adapts the contract", func(t *testing.T) {
+		startsAt, _ := time.Parse(time.RFC3339, "2024-04-01T00:00:00Z")
+
+		contract := dedicatedserver.NewContract()
+		contract.SetId("12123412312")
+		contract.SetStartsAt(startsAt)
+		contract.SetEndsAtNil()
+		contract.SetSla("Bronze")
+		contract.SetContractTerm(36)
+		contract.SetContractType("NORMAL")
+		contract.SetBillingCycle(1)
+		contract.SetBillingFrequency("MONTH")
+		contract.SetPricePerFrequency("12.5")
+		contract.SetCurrency("EUR")
+		contract.SetAggregationPackId("AP-1")
+
+		got := adaptContractToContractModel(contract)
+
+		require.NotNil(t, got)
+		assert.Equal(t, "12123412312", got.ID.ValueString())
+		assert.Equal(t, "2024-04-01 00:00:00 +0000 UTC", got.StartsAt.ValueString())
+		assert.True(t, got.EndsAt.IsNull())
+		assert.Equal(t, "Bronze", got.SLA.ValueString())
+		assert.Equal(t, int32(36), got.ContractTerm.ValueInt32())
+		assert.Equal(t, "NORMAL", got.ContractType.ValueString())
+		assert.Equal(t, int32(1), got.BillingCycle.ValueInt32())
+		assert.Equal(t, "MONTH", got.BillingFrequency.ValueString())
+		assert.Equal(t, "12.5", got.PricePerFrequency.ValueString())
+		assert.Equal(t, "EUR", got.Currency.ValueString())
+		assert.Equal(t, "AP-1", got.AggregationPackID.ValueString())
+		assert.True(t, got.Status.IsNull())
+	})
+}
@@ -2,21 +2,31 @@ package dedicatedserver
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
 var (
-	_ resource.Resource              = &credentialResource{}
-	_ resource.ResourceWithConfigure = &credentialResource{}
+	_ resource.Resource               = &credentialResource{}
+	_ resource.ResourceWithConfigure  = &credentialResource{}
+	_ resource.ResourceWithModifyPlan = &credentialResource{}
 )
 
 type credentialResource struct {
@@ -28,6 +38,32 @@ type credentialResourceModel struct {
 	Username          types.String `tfsdk:"username"`
 	Type              types.String `tfsdk:"type"`
 	Password          types.String `tfsdk:"password"`
+	PasswordPolicy    types.Object `tfsdk:"password_policy"`
+	Keepers           types.Map    `tfsdk:"keepers"`
+}
+
+// password returns the configured password, or generates one from the
+// password policy when the password is not configured.
+func (c credentialResourceModel) password(ctx context.Context, diags *diag.Diagnostics) string {
+	if !c.Password.IsUnknown() {
+		return c.Password.ValueString()
+	}
+
+	policy := defaultPasswordPolicy()
+	if !c.PasswordPolicy.IsNull() && !c.PasswordPolicy.IsUnknown() {
+		diags.Append(c.PasswordPolicy.As(ctx, &policy, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return ""
+		}
+	}
+
+	password, err := generatePassword(policy)
+	if err != nil {
+		utils.ReportError(err.Error(), diags)
+		return ""
+	}
+
+	return password
 }
 
 func NewCredentialResource() resource.Resource {
@@ -70,13 +106,97 @@ func (c *credentialResource) Schema(
 				},
 			},
 			"password": schema.StringAttribute{
-				Required:    true,
-				Description: `The password for the credentials`,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password for the credentials. When omitted, a password is generated according to `password_policy`. Removing a configured password keeps the current password until `keepers` change.",
+			},
+			"password_policy": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The policy of the generated password. Conflicts with `password`.",
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("password")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"length": schema.Int32Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int32default.StaticInt32(defaultPasswordLength),
+						Description: fmt.Sprintf("The length of the password. Defaults to `%d`.", defaultPasswordLength),
+						Validators: []validator.Int32{
+							int32validator.Between(8, 128),
+						},
+					},
+					"lower": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+						Description: "Whether to include lowercase characters. Defaults to `true`.",
+					},
+					"upper": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+						Description: "Whether to include uppercase characters. Defaults to `true`.",
+					},
+					"numeric": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+						Description: "Whether to include numeric characters. Defaults to `true`.",
+					},
+					"special": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+						Description: "Whether to include special characters. Defaults to `true`.",
+					},
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that rotate the generated password in place when they change, i.e. the `id` of a `time_rotating` resource. Conflicts with `password`.",
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("password")),
+				},
 			},
 		},
 	}
 }
 
+func (c *credentialResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// A new password is generated on create, nothing to plan on destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configPassword types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &configPassword)...)
+	if resp.Diagnostics.HasError() || !configPassword.IsNull() {
+		return
+	}
+
+	var plan, state credentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the generated password until the keepers or the policy change.
+	password := state.Password
+	if !plan.Keepers.Equal(state.Keepers) || !plan.PasswordPolicy.Equal(state.PasswordPolicy) {
+		password = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), password)...)
+}
+
 func (c *credentialResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -88,8 +208,13 @@ func (c *credentialResource) Create(
 		return
 	}
 
+	password := plan.password(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	opts := dedicatedserver.NewCreateCredentialOpts(
-		plan.Password.ValueString(),
+		password,
 		dedicatedserver.CredentialType(plan.Type.ValueString()),
 		plan.Username.ValueString(),
 	)
@@ -111,6 +236,8 @@ func (c *credentialResource) Create(
 				Type:              types.StringValue(string(result.GetType())),
 				Password:          types.StringValue(result.GetPassword()),
 				Username:          types.StringValue(result.GetUsername()),
+				PasswordPolicy:    plan.PasswordPolicy,
+				Keepers:           plan.Keepers,
 			},
 		)...,
 	)
@@ -147,6 +274,8 @@ func (c *credentialResource) Read(
 				Type:              types.StringValue(string(result.GetType())),
 				Password:          types.StringValue(result.GetPassword()),
 				Username:          types.StringValue(result.GetUsername()),
+				PasswordPolicy:    state.PasswordPolicy,
+				Keepers:           state.Keepers,
 			},
 		)...,
 	)
@@ -163,9 +292,12 @@ func (c *credentialResource) Update(
 		return
 	}
 
-	opts := dedicatedserver.NewUpdateCredentialOpts(
-		plan.Password.ValueString(),
-	)
+	password := plan.password(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := dedicatedserver.NewUpdateCredentialOpts(password)
 	request := c.DedicatedserverAPI.UpdateCredential(
 		ctx,
 		plan.DedicatedServerID.ValueString(),
@@ -186,6 +318,8 @@ func (c *credentialResource) Update(
 				Type:              types.StringValue(string(result.GetType())),
 				Password:          types.StringValue(result.GetPassword()),
 				Username:          types.StringValue(result.GetUsername()),
+				PasswordPolicy:    plan.PasswordPolicy,
+				Keepers:           plan.Keepers,
 			},
 		)...,
 	)
@@ -0,0 +1,154 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &credentialsDataSource{}
+	_ datasource.DataSourceWithConfigure = &credentialsDataSource{}
+)
+
+type credentialsDataSource struct {
+	utils.DataSourceAPI
+}
+
+type credentialsCredentialDataSourceModel struct {
+	Type     types.String `tfsdk:"type"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+type credentialsDataSourceModel struct {
+	DedicatedServerID types.String                           `tfsdk:"dedicated_server_id"`
+	IncludePasswords  types.Bool                             `tfsdk:"include_passwords"`
+	Credentials       []credentialsCredentialDataSourceModel `tfsdk:"credentials"`
+}
+
+func adaptCredentialToCredentialsDataSource(
+	credential dedicatedserver.CredentialWithoutPassword,
+) credentialsCredentialDataSourceModel {
+	return credentialsCredentialDataSourceModel{
+		Type:     basetypes.NewStringValue(string(credential.GetType())),
+		Username: basetypes.NewStringValue(credential.GetUsername()),
+		Password: basetypes.NewStringNull(),
+	}
+}
+
+func NewCredentialsDataSource() datasource.DataSource {
+	return &credentialsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_credentials",
+		},
+	}
+}
+
+func (c *credentialsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve all credentials stored for a dedicated server.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+			},
+			"include_passwords": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to retrieve the passwords of the credentials. This requires an extra API call per credential. Defaults to `false`.",
+			},
+			"credentials": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The credentials of the dedicated server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the credential.",
+						},
+						"username": schema.StringAttribute{
+							Computed:    true,
+							Description: "The username of the credential.",
+						},
+						"password": schema.StringAttribute{
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The password of the credential. Only set when `include_passwords` is `true`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *credentialsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config credentialsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.DedicatedServerID.ValueString()
+	state := credentialsDataSourceModel{
+		DedicatedServerID: config.DedicatedServerID,
+		IncludePasswords:  config.IncludePasswords,
+		Credentials:       []credentialsCredentialDataSourceModel{},
+	}
+
+	request := c.DedicatedserverAPI.GetCredentialList(ctx, serverID)
+	credentials, response, err := utils.GetAllPages(
+		func(offset int32) (*utils.Page[dedicatedserver.CredentialWithoutPassword], *http.Response, error) {
+			result, response, err := request.Limit(pageLimit).Offset(offset).Execute()
+			if err != nil {
+				return nil, response, err
+			}
+
+			return newPage(result.GetCredentials(), result.GetMetadata()), nil, nil
+		},
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	for _, credential := range credentials {
+		state.Credentials = append(
+			state.Credentials,
+			adaptCredentialToCredentialsDataSource(credential),
+		)
+	}
+
+	if config.IncludePasswords.ValueBool() {
+		for i, credential := range state.Credentials {
+			result, response, err := c.DedicatedserverAPI.GetCredential(
+				ctx,
+				serverID,
+				dedicatedserver.CredentialType(credential.Type.ValueString()),
+				credential.Username.ValueString(),
+			).Execute()
+			if err != nil {
+				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				return
+			}
+
+			state.Credentials[i].Password = basetypes.NewStringValue(result.GetPassword())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,19 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptCredentialToCredentialsDataSource(t *testing.T) {
+	got := adaptCredentialToCredentialsDataSource(dedicatedserver.CredentialWithoutPassword{
+		Type:     dedicatedserver.CREDENTIALTYPE_REMOTE_MANAGEMENT,
+		Username: "admin",
+	})
+
+	assert.Equal(t, "REMOTE_MANAGEMENT", got.Type.ValueString())
+	assert.Equal(t, "admin", got.Username.ValueString())
+	assert.True(t, got.Password.IsNull())
+}
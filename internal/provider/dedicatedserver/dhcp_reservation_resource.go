@@ -0,0 +1,295 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &dhcpReservationResource{}
+	_ resource.ResourceWithImportState = &dhcpReservationResource{}
+)
+
+type dhcpReservationResource struct {
+	utils.ResourceAPI
+}
+
+type dhcpReservationResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	Bootfile          types.String `tfsdk:"bootfile"`
+	Hostname          types.String `tfsdk:"hostname"`
+	Lease             types.Object `tfsdk:"lease"`
+}
+
+type leaseResourceModel struct {
+	CreatedAt types.String `tfsdk:"created_at"`
+	Gateway   types.String `tfsdk:"gateway"`
+	IP        types.String `tfsdk:"ip"`
+	Mac       types.String `tfsdk:"mac"`
+	Netmask   types.String `tfsdk:"netmask"`
+	Site      types.String `tfsdk:"site"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (l leaseResourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"created_at": types.StringType,
+		"gateway":    types.StringType,
+		"ip":         types.StringType,
+		"mac":        types.StringType,
+		"netmask":    types.StringType,
+		"site":       types.StringType,
+		"updated_at": types.StringType,
+	}
+}
+
+func adaptLeaseToDhcpReservationResource(
+	ctx context.Context,
+	lease dedicatedserver.Lease,
+	dedicatedServerID types.String,
+) (*dhcpReservationResourceModel, diag.Diagnostics) {
+	leaseObject, diags := types.ObjectValueFrom(
+		ctx,
+		leaseResourceModel{}.attributeTypes(),
+		leaseResourceModel{
+			CreatedAt: types.StringValue(lease.GetCreatedAt()),
+			Gateway:   types.StringValue(lease.GetGateway()),
+			IP:        types.StringValue(lease.GetIp()),
+			Mac:       types.StringValue(lease.GetMac()),
+			Netmask:   types.StringValue(lease.GetNetmask()),
+			Site:      types.StringValue(lease.GetSite()),
+			UpdatedAt: types.StringValue(lease.GetUpdatedAt()),
+		},
+	)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &dhcpReservationResourceModel{
+		DedicatedServerID: dedicatedServerID,
+		Bootfile:          types.StringValue(lease.GetBootfile()),
+		Hostname:          types.StringValue(lease.GetHostname()),
+		Lease:             leaseObject,
+	}, nil
+}
+
+func NewDhcpReservationResource() resource.Resource {
+	return &dhcpReservationResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_dhcp_reservation",
+		},
+	}
+}
+
+func (d *dhcpReservationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the DHCP reservation of a dedicated server, i.e. to network boot the server from a PXE bootfile. Do not combine with the `dhcp_lease` attribute of `leaseweb_dedicated_server` for the same server.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bootfile": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of PXE boot you want your server to boot from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The hostname for the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lease": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The active DHCP lease of the reservation.",
+				Attributes: map[string]schema.Attribute{
+					"created_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time when the DHCP reservation was created.",
+					},
+					"gateway": schema.StringAttribute{
+						Computed:    true,
+						Description: "The gateway for this DHCP reservation.",
+					},
+					"ip": schema.StringAttribute{
+						Computed:    true,
+						Description: "The IP address this DHCP reservation is for.",
+					},
+					"mac": schema.StringAttribute{
+						Computed:    true,
+						Description: "The MAC address this DHCP reservation is for.",
+					},
+					"netmask": schema.StringAttribute{
+						Computed:    true,
+						Description: "The netmask for this DHCP reservation.",
+					},
+					"site": schema.StringAttribute{
+						Computed:    true,
+						Description: "The site serving this DHCP reservation.",
+					},
+					"updated_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time when the DHCP reservation was last updated or used by a client.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dhcpReservationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan dhcpReservationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.DedicatedServerID.ValueString()
+	opts := dedicatedserver.NewCreateDhcpReservationOpts(plan.Bootfile.ValueString())
+	opts.Hostname = utils.AdaptStringPointerValueToNullableString(plan.Hostname)
+	response, err := d.DedicatedserverAPI.CreateDhcpReservation(ctx, serverID).
+		CreateDhcpReservationOpts(*opts).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	lease := d.getLease(ctx, serverID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lease == nil {
+		utils.ReportError("The DHCP reservation could not be found after it has been created", &resp.Diagnostics)
+		return
+	}
+
+	state, diags := adaptLeaseToDhcpReservationResource(ctx, *lease, plan.DedicatedServerID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (d *dhcpReservationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state dhcpReservationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lease := d.getLease(ctx, state.DedicatedServerID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The reservation has been removed outside of Terraform.
+	if lease == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState, diags := adaptLeaseToDhcpReservationResource(ctx, *lease, state.DedicatedServerID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (d *dhcpReservationResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+func (d *dhcpReservationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state dhcpReservationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.DedicatedserverAPI.DeleteDhcpReservation(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+	}
+}
+
+func (d *dhcpReservationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(
+		ctx,
+		path.Root("dedicated_server_id"),
+		req,
+		resp,
+	)
+}
+
+// getLease returns the active DHCP reservation of the server, if any.
+func (d *dhcpReservationResource) getLease(
+	ctx context.Context,
+	serverID string,
+	diags *diag.Diagnostics,
+) *dedicatedserver.Lease {
+	result, response, err := d.DedicatedserverAPI.GetDhcpReservationList(
+		ctx,
+		serverID,
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return nil
+	}
+
+	leases := result.GetLeases()
+	if len(leases) == 0 {
+		return nil
+	}
+
+	return &leases[0]
+}
@@ -0,0 +1,43 @@
+package dedicatedserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptLeaseToDhcpReservationResource(t *testing.T) {
+	bootfile := "http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe"
+	hostname := "my-server"
+	ip := "192.168.0.100"
+	mac := "AA:BB:CC:DD:EE:FF"
+	site := "AMS-01"
+
+	got, diags := adaptLeaseToDhcpReservationResource(
+		context.TODO(),
+		dedicatedserver.Lease{
+			Bootfile: &bootfile,
+			Hostname: &hostname,
+			Ip:       &ip,
+			Mac:      &mac,
+			Site:     &site,
+		},
+		basetypes.NewStringValue("12345"),
+	)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+	assert.Equal(t, bootfile, got.Bootfile.ValueString())
+	assert.Equal(t, hostname, got.Hostname.ValueString())
+
+	lease := leaseResourceModel{}
+	got.Lease.As(context.TODO(), &lease, basetypes.ObjectAsOptions{})
+	assert.Equal(t, ip, lease.IP.ValueString())
+	assert.Equal(t, mac, lease.Mac.ValueString())
+	assert.Equal(t, site, lease.Site.ValueString())
+	assert.Equal(t, "", lease.Gateway.ValueString())
+}
@@ -0,0 +1,438 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &hardwareDataSource{}
+	_ datasource.DataSourceWithConfigure = &hardwareDataSource{}
+)
+
+type hardwareDataSource struct {
+	utils.DataSourceAPI
+}
+
+type hardwareCPUDataSourceModel struct {
+	Cores        types.String `tfsdk:"cores"`
+	Description  types.String `tfsdk:"description"`
+	EnabledCores types.String `tfsdk:"enabled_cores"`
+	Hz           types.String `tfsdk:"hz"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	Slot         types.String `tfsdk:"slot"`
+	Threads      types.String `tfsdk:"threads"`
+	Vendor       types.String `tfsdk:"vendor"`
+}
+
+type hardwareMemoryDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ClockHz      types.String `tfsdk:"clock_hz"`
+	Description  types.String `tfsdk:"description"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	SizeBytes    types.String `tfsdk:"size_bytes"`
+}
+
+type hardwareSmartDataSourceModel struct {
+	DeviceModel     types.String `tfsdk:"device_model"`
+	ExecutionStatus types.String `tfsdk:"execution_status"`
+	FirmwareVersion types.String `tfsdk:"firmware_version"`
+	OverallHealth   types.String `tfsdk:"overall_health"`
+	Rpm             types.String `tfsdk:"rpm"`
+	SmartAvailable  types.Bool   `tfsdk:"smart_available"`
+	SmartEnabled    types.Bool   `tfsdk:"smart_enabled"`
+	SmartErrorLog   types.String `tfsdk:"smart_error_log"`
+	UserCapacity    types.String `tfsdk:"user_capacity"`
+}
+
+type hardwareDiskDataSourceModel struct {
+	ID           types.String                  `tfsdk:"id"`
+	Description  types.String                  `tfsdk:"description"`
+	Product      types.String                  `tfsdk:"product"`
+	SerialNumber types.String                  `tfsdk:"serial_number"`
+	Size         types.String                  `tfsdk:"size"`
+	Smart        *hardwareSmartDataSourceModel `tfsdk:"smart"`
+	Vendor       types.String                  `tfsdk:"vendor"`
+}
+
+type hardwareNetworkInterfaceDataSourceModel struct {
+	Driver      types.String `tfsdk:"driver"`
+	Duplex      types.String `tfsdk:"duplex"`
+	Firmware    types.String `tfsdk:"firmware"`
+	IP          types.String `tfsdk:"ip"`
+	Link        types.String `tfsdk:"link"`
+	LogicalName types.String `tfsdk:"logical_name"`
+	MacAddress  types.String `tfsdk:"mac_address"`
+	Product     types.String `tfsdk:"product"`
+	Speed       types.String `tfsdk:"speed"`
+	Vendor      types.String `tfsdk:"vendor"`
+}
+
+type hardwareDataSourceModel struct {
+	ID                types.String                              `tfsdk:"id"`
+	DedicatedServerID types.String                              `tfsdk:"dedicated_server_id"`
+	CPUs              []hardwareCPUDataSourceModel              `tfsdk:"cpus"`
+	Disks             []hardwareDiskDataSourceModel             `tfsdk:"disks"`
+	Memory            []hardwareMemoryDataSourceModel           `tfsdk:"memory"`
+	NetworkInterfaces []hardwareNetworkInterfaceDataSourceModel `tfsdk:"network_interfaces"`
+	ParserVersion     types.String                              `tfsdk:"parser_version"`
+	ScannedAt         types.String                              `tfsdk:"scanned_at"`
+}
+
+func adaptGetHardwareResultToHardwareDataSource(
+	hardware dedicatedserver.GetHardwareResult,
+	dedicatedServerID types.String,
+) hardwareDataSourceModel {
+	result := hardware.GetResult()
+
+	var cpus []hardwareCPUDataSourceModel
+	for _, cpu := range result.GetCpu() {
+		settings := cpu.GetSettings()
+		cpus = append(cpus, hardwareCPUDataSourceModel{
+			Cores:        basetypes.NewStringValue(settings.GetCores()),
+			Description:  basetypes.NewStringValue(cpu.GetDescription()),
+			EnabledCores: basetypes.NewStringValue(settings.GetEnabledcores()),
+			Hz:           basetypes.NewStringValue(cpu.GetHz()),
+			SerialNumber: basetypes.NewStringValue(cpu.GetSerialNumber()),
+			Slot:         basetypes.NewStringValue(cpu.GetSlot()),
+			Threads:      basetypes.NewStringValue(settings.GetThreads()),
+			Vendor:       basetypes.NewStringValue(cpu.GetVendor()),
+		})
+	}
+
+	var disks []hardwareDiskDataSourceModel
+	for _, disk := range result.GetDisks() {
+		var smart *hardwareSmartDataSourceModel
+		if smartctl, ok := disk.GetSmartctlOk(); ok {
+			smartSupport := smartctl.GetSmartSupport()
+			smart = &hardwareSmartDataSourceModel{
+				DeviceModel:     basetypes.NewStringValue(smartctl.GetDeviceModel()),
+				ExecutionStatus: basetypes.NewStringValue(smartctl.GetExecutionStatus()),
+				FirmwareVersion: basetypes.NewStringValue(smartctl.GetFirmwareVersion()),
+				OverallHealth:   basetypes.NewStringValue(smartctl.GetOverallHealth()),
+				Rpm:             basetypes.NewStringValue(smartctl.GetRpm()),
+				SmartAvailable:  basetypes.NewBoolValue(smartSupport.GetAvailable()),
+				SmartEnabled:    basetypes.NewBoolValue(smartSupport.GetEnabled()),
+				SmartErrorLog:   basetypes.NewStringValue(smartctl.GetSmartErrorLog()),
+				UserCapacity:    basetypes.NewStringValue(smartctl.GetUserCapacity()),
+			}
+		}
+
+		disks = append(disks, hardwareDiskDataSourceModel{
+			ID:           basetypes.NewStringValue(disk.GetId()),
+			Description:  basetypes.NewStringValue(disk.GetDescription()),
+			Product:      basetypes.NewStringValue(disk.GetProduct()),
+			SerialNumber: basetypes.NewStringValue(disk.GetSerialNumber()),
+			Size:         basetypes.NewStringValue(disk.GetSize()),
+			Smart:        smart,
+			Vendor:       basetypes.NewStringValue(disk.GetVendor()),
+		})
+	}
+
+	var memory []hardwareMemoryDataSourceModel
+	for _, memoryBank := range result.GetMemory() {
+		memory = append(memory, hardwareMemoryDataSourceModel{
+			ID:           basetypes.NewStringValue(memoryBank.GetId()),
+			ClockHz:      basetypes.NewStringValue(memoryBank.GetClockHz()),
+			Description:  basetypes.NewStringValue(memoryBank.GetDescription()),
+			SerialNumber: basetypes.NewStringValue(memoryBank.GetSerialNumber()),
+			SizeBytes:    basetypes.NewStringValue(memoryBank.GetSizeBytes()),
+		})
+	}
+
+	var networkInterfaces []hardwareNetworkInterfaceDataSourceModel
+	for _, network := range result.GetNetwork() {
+		settings := network.GetSettings()
+		networkInterfaces = append(
+			networkInterfaces,
+			hardwareNetworkInterfaceDataSourceModel{
+				Driver:      basetypes.NewStringValue(settings.GetDriver()),
+				Duplex:      basetypes.NewStringValue(settings.GetDuplex()),
+				Firmware:    basetypes.NewStringValue(settings.GetFirmware()),
+				IP:          basetypes.NewStringValue(settings.GetIp()),
+				Link:        basetypes.NewStringValue(settings.GetLink()),
+				LogicalName: basetypes.NewStringValue(network.GetLogicalName()),
+				MacAddress:  basetypes.NewStringValue(network.GetMacAddress()),
+				Product:     basetypes.NewStringValue(network.GetProduct()),
+				Speed:       basetypes.NewStringValue(settings.GetSpeed()),
+				Vendor:      basetypes.NewStringValue(network.GetVendor()),
+			},
+		)
+	}
+
+	scannedAt, _ := hardware.GetScannedAtOk()
+
+	return hardwareDataSourceModel{
+		ID:                basetypes.NewStringValue(hardware.GetId()),
+		DedicatedServerID: dedicatedServerID,
+		CPUs:              cpus,
+		Disks:             disks,
+		Memory:            memory,
+		NetworkInterfaces: networkInterfaces,
+		ParserVersion:     basetypes.NewStringValue(hardware.GetParserVersion()),
+		ScannedAt:         utils.AdaptNullableTimeToStringValue(scannedAt),
+	}
+}
+
+func (h *hardwareDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the result of the latest hardware scan of a dedicated server",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Id of the hardware scan result.",
+			},
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+			},
+			"cpus": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The processors of the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cores": schema.StringAttribute{
+							Computed:    true,
+							Description: "Number of cores.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the processor.",
+						},
+						"enabled_cores": schema.StringAttribute{
+							Computed:    true,
+							Description: "Number of enabled cores.",
+						},
+						"hz": schema.StringAttribute{
+							Computed:    true,
+							Description: "Clock speed in Hz.",
+						},
+						"serial_number": schema.StringAttribute{
+							Computed:    true,
+							Description: "Serial number of the processor.",
+						},
+						"slot": schema.StringAttribute{
+							Computed:    true,
+							Description: "Socket the processor is installed in.",
+						},
+						"threads": schema.StringAttribute{
+							Computed:    true,
+							Description: "Number of threads.",
+						},
+						"vendor": schema.StringAttribute{
+							Computed:    true,
+							Description: "Vendor of the processor.",
+						},
+					},
+				},
+			},
+			"disks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The disks of the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Id of the disk.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the disk.",
+						},
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "Product name of the disk.",
+						},
+						"serial_number": schema.StringAttribute{
+							Computed:    true,
+							Description: "Serial number of the disk.",
+						},
+						"size": schema.StringAttribute{
+							Computed:    true,
+							Description: "Size in bytes.",
+						},
+						"smart": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Summary of the SMART data of the disk.",
+							Attributes: map[string]schema.Attribute{
+								"device_model": schema.StringAttribute{
+									Computed:    true,
+									Description: "Device model reported by SMART.",
+								},
+								"execution_status": schema.StringAttribute{
+									Computed:    true,
+									Description: "Status of the last SMART self-test execution.",
+								},
+								"firmware_version": schema.StringAttribute{
+									Computed:    true,
+									Description: "Firmware version of the disk.",
+								},
+								"overall_health": schema.StringAttribute{
+									Computed:    true,
+									Description: "Result of the SMART overall-health self-assessment test, i.e.: *PASSED*.",
+								},
+								"rpm": schema.StringAttribute{
+									Computed:    true,
+									Description: "Rotation rate of the disk, i.e.: *Solid State Device* or *7200 rpm*.",
+								},
+								"smart_available": schema.BoolAttribute{
+									Computed:    true,
+									Description: "Whether the disk supports SMART.",
+								},
+								"smart_enabled": schema.BoolAttribute{
+									Computed:    true,
+									Description: "Whether SMART is enabled on the disk.",
+								},
+								"smart_error_log": schema.StringAttribute{
+									Computed:    true,
+									Description: "Number of errors in the SMART error log.",
+								},
+								"user_capacity": schema.StringAttribute{
+									Computed:    true,
+									Description: "Capacity of the disk as reported by SMART.",
+								},
+							},
+						},
+						"vendor": schema.StringAttribute{
+							Computed:    true,
+							Description: "Vendor of the disk.",
+						},
+					},
+				},
+			},
+			"memory": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The memory banks of the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Id of the memory bank.",
+						},
+						"clock_hz": schema.StringAttribute{
+							Computed:    true,
+							Description: "Clock speed in Hz.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the memory bank.",
+						},
+						"serial_number": schema.StringAttribute{
+							Computed:    true,
+							Description: "Serial number of the memory bank.",
+						},
+						"size_bytes": schema.StringAttribute{
+							Computed:    true,
+							Description: "Size in bytes.",
+						},
+					},
+				},
+			},
+			"network_interfaces": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The network interfaces of the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"driver": schema.StringAttribute{
+							Computed:    true,
+							Description: "Driver of the interface.",
+						},
+						"duplex": schema.StringAttribute{
+							Computed:    true,
+							Description: "Duplex mode of the interface, i.e.: *full*.",
+						},
+						"firmware": schema.StringAttribute{
+							Computed:    true,
+							Description: "Firmware version of the interface.",
+						},
+						"ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "IP address configured on the interface.",
+						},
+						"link": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether the interface has a link, i.e.: *yes*.",
+						},
+						"logical_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the interface in the operating system, i.e.: *eth0*.",
+						},
+						"mac_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "MAC address of the interface.",
+						},
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "Product name of the interface.",
+						},
+						"speed": schema.StringAttribute{
+							Computed:    true,
+							Description: "Link speed of the interface, i.e.: *1Gbit/s*.",
+						},
+						"vendor": schema.StringAttribute{
+							Computed:    true,
+							Description: "Vendor of the interface.",
+						},
+					},
+				},
+			},
+			"parser_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the parser used for this hardware info.",
+			},
+			"scanned_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the hardware scan.",
+			},
+		},
+	}
+}
+
+func (h *hardwareDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config hardwareDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hardware, response, err := h.DedicatedserverAPI.GetHardware(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(
+			ctx,
+			adaptGetHardwareResultToHardwareDataSource(
+				*hardware,
+				config.DedicatedServerID,
+			),
+		)...,
+	)
+}
+
+func NewHardwareDataSource() datasource.DataSource {
+	return &hardwareDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_hardware",
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package dedicatedserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptGetHardwareResultToHardwareDataSource(t *testing.T) {
+	scannedAt, _ := time.Parse("2006-01-02 15:04:05", "2017-09-27 14:21:01")
+	id := "2378237"
+	cores := "4"
+	cpuDescription := "Intel(R) Xeon(R) CPU E31230"
+	diskID := "disk:0"
+	overallHealth := "PASSED"
+	smartEnabled := true
+	memoryID := "memory/bank:0"
+	sizeBytes := "4294967296"
+	logicalName := "eth0"
+	speed := "1Gbit/s"
+
+	hardware := dedicatedserver.GetHardwareResult{
+		Id:        &id,
+		ScannedAt: &scannedAt,
+		Result: &dedicatedserver.Result{
+			Cpu: []dedicatedserver.HardwareCpu{
+				{
+					Description: &cpuDescription,
+					Settings:    &dedicatedserver.CpuSettings{Cores: &cores},
+				},
+			},
+			Disks: []dedicatedserver.Disk{
+				{
+					Id: &diskID,
+					Smartctl: &dedicatedserver.Smartctl{
+						OverallHealth: &overallHealth,
+						SmartSupport: &dedicatedserver.SmartSupport{
+							Enabled: &smartEnabled,
+						},
+					},
+				},
+				{},
+			},
+			Memory: []dedicatedserver.MemoryBank{
+				{Id: &memoryID, SizeBytes: &sizeBytes},
+			},
+			Network: []dedicatedserver.Network{
+				{
+					LogicalName: &logicalName,
+					Settings:    &dedicatedserver.NetworkSettings{Speed: &speed},
+				},
+			},
+		},
+	}
+
+	got := adaptGetHardwareResultToHardwareDataSource(
+		hardware,
+		basetypes.NewStringValue("12345"),
+	)
+
+	assert.Equal(t, "2378237", got.ID.ValueString())
+	assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+	assert.Equal(t, "2017-09-27 14:21:01 +0000 UTC", got.ScannedAt.ValueString())
+
+	require.Len(t, got.CPUs, 1)
+	assert.Equal(t, cpuDescription, got.CPUs[0].Description.ValueString())
+	assert.Equal(t, "4", got.CPUs[0].Cores.ValueString())
+
+	require.Len(t, got.Disks, 2)
+	assert.Equal(t, "disk:0", got.Disks[0].ID.ValueString())
+	require.NotNil(t, got.Disks[0].Smart)
+	assert.Equal(t, "PASSED", got.Disks[0].Smart.OverallHealth.ValueString())
+	assert.True(t, got.Disks[0].Smart.SmartEnabled.ValueBool())
+	assert.False(t, got.Disks[0].Smart.SmartAvailable.ValueBool())
+	assert.Nil(t, got.Disks[1].Smart)
+
+	require.Len(t, got.Memory, 1)
+	assert.Equal(t, "4294967296", got.Memory[0].SizeBytes.ValueString())
+
+	require.Len(t, got.NetworkInterfaces, 1)
+	assert.Equal(t, "eth0", got.NetworkInterfaces[0].LogicalName.ValueString())
+	assert.Equal(t, "1Gbit/s", got.NetworkInterfaces[0].Speed.ValueString())
+}
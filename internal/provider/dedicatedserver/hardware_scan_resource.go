@@ -0,0 +1,34 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+func NewHardwareScanResource() resource.Resource {
+	return &triggeredJobResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_hardware_scan",
+		},
+		description:           "Runs a hardware scan on a dedicated server and waits for it to finish. The result can be retrieved with the `leaseweb_dedicated_server_hardware` data source.",
+		jobName:               "hardware scan",
+		powerCycleDescription: "If true, the server is power cycled in order to run the hardware scan.",
+		startJob: func(
+			ctx context.Context,
+			api dedicatedserver.DedicatedserverAPI,
+			request triggeredJobRequest,
+		) (*dedicatedserver.Job, *http.Response, error) {
+			opts := dedicatedserver.NewScanHardwareOpts()
+			opts.CallbackUrl = request.callbackURL
+			opts.PowerCycle = request.powerCycle
+
+			return api.ScanHardware(ctx, request.serverID).
+				ScanHardwareOpts(*opts).
+				Execute()
+		},
+	}
+}
@@ -3,12 +3,11 @@ package dedicatedserver
 import (
 	"context"
 	"encoding/base64"
-	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
-	"time"
 
-	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -16,8 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -29,10 +30,22 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &installationResource{}
-	_ resource.ResourceWithImportState = &installationResource{}
+	_ resource.ResourceWithConfigure      = &installationResource{}
+	_ resource.ResourceWithImportState    = &installationResource{}
+	_ resource.ResourceWithValidateConfig = &installationResource{}
+	_ resource.ResourceWithModifyPlan     = &installationResource{}
 )
 
+// raidMinimumDisks holds the minimum number of disks required per RAID level.
+// The operating system defaults do not include RAID settings, so these are the
+// standard requirements of each level.
+var raidMinimumDisks = map[int32]int32{
+	0:  2,
+	1:  2,
+	5:  3,
+	10: 4,
+}
+
 func NewInstallationResource() resource.Resource {
 	return &installationResource{
 		ResourceAPI: utils.ResourceAPI{
@@ -60,6 +73,9 @@ type installationResourceModel struct {
 	Raid              types.Object   `tfsdk:"raid"`
 	SSHKeys           []types.String `tfsdk:"ssh_keys"`
 	Timezone          types.String   `tfsdk:"timezone"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	CancelOnDestroy   types.Bool     `tfsdk:"cancel_on_destroy"`
+	Triggers          types.Map      `tfsdk:"triggers"`
 }
 
 type raidResourceModel struct {
@@ -74,6 +90,14 @@ type partitionsResourceModel struct {
 	Size       types.String `tfsdk:"size"`
 }
 
+func (p partitionsResourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"filesystem": types.StringType,
+		"mountpoint": types.StringType,
+		"size":       types.StringType,
+	}
+}
+
 func (i *installationResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -94,7 +118,7 @@ func (i *installationResource) Schema(
 					},
 				},
 				"number_of_disks": schema.Int32Attribute{
-					Description: "The number of disks you want to apply RAID on. If not specified all disks are used",
+					Description: "The number of disks you want to apply RAID on. If not specified all disks are used. At least 2 disks are required for level *0* and *1*, 3 for level *5* and an even number of at least 4 for level *10*",
 					Optional:    true,
 					PlanModifiers: []planmodifier.Int32{
 						int32planmodifier.RequiresReplace(),
@@ -242,6 +266,26 @@ func (i *installationResource) Schema(
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger a new installation",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"cancel_on_destroy": schema.BoolAttribute{
+				Description: "If true, destroying the resource cancels the installation job when it is still running. Defaults to `false`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "If true, waits until the installation job has finished. Otherwise, the resource is created as soon as the installation job is accepted and its progress can be followed with the `leaseweb_dedicated_server_job` data source. Defaults to `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 		},
 	}
 
@@ -251,6 +295,197 @@ func (i *installationResource) Schema(
 	)
 }
 
+func (i *installationResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var partitions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("partitions"), &partitions)...)
+
+	var raid types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("raid"), &raid)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !partitions.IsNull() && !partitions.IsUnknown() {
+		var partitionsConfig []partitionsResourceModel
+		resp.Diagnostics.Append(partitions.ElementsAs(ctx, &partitionsConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		validatePartitions(partitionsConfig, &resp.Diagnostics)
+	}
+
+	if !raid.IsNull() && !raid.IsUnknown() {
+		var raidConfig raidResourceModel
+		resp.Diagnostics.Append(raid.As(ctx, &raidConfig, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		validateRaid(raidConfig, &resp.Diagnostics)
+	}
+}
+
+func (i *installationResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// The provider has not been configured yet, so the API cannot be reached.
+	if req.Plan.Raw.IsNull() || i.DedicatedserverAPI == nil {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		i.planReplacementOnDrift(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Nothing to validate when the resource is left untouched.
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var operatingSystemID, controlPanelID types.String
+	var partitions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("operating_system_id"), &operatingSystemID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("control_panel_id"), &controlPanelID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("partitions"), &partitions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if operatingSystemID.IsUnknown() || operatingSystemID.IsNull() || partitions.IsNull() || partitions.IsUnknown() {
+		return
+	}
+
+	var partitionsConfig []partitionsResourceModel
+	resp.Diagnostics.Append(partitions.ElementsAs(ctx, &partitionsConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := i.DedicatedserverAPI.GetOperatingSystem(ctx, operatingSystemID.ValueString())
+	if !controlPanelID.IsNull() && !controlPanelID.IsUnknown() {
+		request = request.ControlPanelId(controlPanelID.ValueString())
+	}
+	operatingSystem, response, err := request.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	validatePartitionFilesystems(partitionsConfig, *operatingSystem, &resp.Diagnostics)
+	validateRootPartition(partitionsConfig, *operatingSystem, &resp.Diagnostics)
+}
+
+// planReplacementOnDrift plans a new installation when the server has been
+// reinstalled outside of Terraform, i.e. through the customer portal.
+func (i *installationResource) planReplacementOnDrift(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var state installationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.DedicatedServerID.ValueString()
+	latestJob, response, err := findNewerFinishedInstallation(
+		ctx,
+		i.DedicatedserverAPI,
+		serverID,
+		state.ID.ValueString(),
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+	if latestJob == nil {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Installation changed outside of Terraform",
+		fmt.Sprintf(
+			"The latest installation job of server %s is %s instead of %s, a new installation is planned.",
+			serverID,
+			latestJob.GetUuid(),
+			state.ID.ValueString(),
+		),
+	)
+
+	// Attributes that are not configured are computed by the new
+	// installation, so their current values cannot be kept.
+	computedAttributes := map[string]attr.Value{
+		"id":          types.StringUnknown(),
+		"device":      types.StringUnknown(),
+		"hostname":    types.StringUnknown(),
+		"partitions":  types.ListUnknown(types.ObjectType{AttrTypes: partitionsResourceModel{}.attributeTypes()}),
+		"power_cycle": types.BoolUnknown(),
+		"timezone":    types.StringUnknown(),
+	}
+	for name, unknown := range computedAttributes {
+		var configValue attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configValue)...)
+		if name == "id" || configValue == nil || configValue.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), unknown)...)
+		}
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("id"))
+}
+
+// findNewerFinishedInstallation returns the latest finished installation job
+// of the server when it was created after the installation in the state.
+// Nothing is returned while the installation in the state is still running or
+// has failed, as the latest finished job is then an older installation.
+func findNewerFinishedInstallation(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	installationID string,
+) (*dedicatedserver.ServerJob, *http.Response, error) {
+	installation, response, err := api.GetJob(ctx, serverID, installationID).Execute()
+	if err != nil {
+		// Purged jobs cannot be compared with the latest installation.
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, nil, nil
+		}
+
+		return nil, response, err
+	}
+	if installation.GetStatus() != "FINISHED" {
+		return nil, nil, nil
+	}
+
+	result, response, err := api.GetJobList(ctx, serverID).
+		Offset(0).Limit(1).Type_("install").Status("FINISHED").Execute()
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, job := range result.GetJobs() {
+		if job.GetStatus() != "FINISHED" || job.GetUuid() == installationID {
+			continue
+		}
+		if job.GetCreatedAt().After(installation.GetCreatedAt()) {
+			return &job, nil, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
 func (i *installationResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -339,14 +574,19 @@ func (i *installationResource) Create(
 		return
 	}
 
-	job, err := i.waitForJobAndRetrieveUntilFinished(serverID, installationJob.GetUuid(), ctx, resp)
-	if err != nil {
-		utils.ReportError(err.Error(), &resp.Diagnostics)
-		return
+	payload := installationJob.GetPayload()
+	plan.ID = types.StringValue(installationJob.GetUuid())
+
+	if plan.WaitForCompletion.ValueBool() {
+		job, response, err := waitForJobAndRetrieveUntilFinished(ctx, i.DedicatedserverAPI, serverID, installationJob.GetUuid())
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+		payload = job.GetPayload()
 	}
-	plan.ID = types.StringValue(job.GetUuid())
 
-	diags := i.syncResourceModelWithSDK(&plan, job.GetPayload(), ctx)
+	diags := i.syncResourceModelWithSDK(&plan, payload, ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -377,24 +617,44 @@ func (i *installationResource) Read(
 
 	serverID := state.DedicatedServerID.ValueString()
 
-	result, response, err := i.DedicatedserverAPI.GetJobList(ctx, serverID).
-		Offset(0).Limit(1).Type_("install").Status("FINISHED").Execute()
+	// Jobs created without waiting for completion might not have finished
+	// yet, so they are retrieved directly.
+	var payload dedicatedserver.ServerJobPayload
+	if !state.ID.IsNull() && !state.ID.IsUnknown() && state.ID.ValueString() != "" {
+		job, response, err := i.DedicatedserverAPI.GetJob(ctx, serverID, state.ID.ValueString()).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+		payload = job.GetPayload()
+	} else {
+		result, response, err := i.DedicatedserverAPI.GetJobList(ctx, serverID).
+			Offset(0).Limit(1).Type_("install").Status("FINISHED").Execute()
+
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
 
-	if err != nil {
-		utils.SdkError(ctx, &resp.Diagnostics, err, response)
-		return
-	}
+		jobs := result.GetJobs()
 
-	jobs := result.GetJobs()
+		if len(jobs) == 0 {
+			utils.ReportError(fmt.Sprintf("No installation jobs found for server %s", serverID), &resp.Diagnostics)
+			return
+		}
+		job := jobs[0]
+		state.ID = types.StringValue(job.GetUuid())
+		payload = job.GetPayload()
+	}
 
-	if len(jobs) == 0 {
-		utils.ReportError(fmt.Sprintf("No installation jobs found for server %s", serverID), &resp.Diagnostics)
-		return
+	if state.WaitForCompletion.IsNull() {
+		state.WaitForCompletion = types.BoolValue(true)
+	}
+	if state.CancelOnDestroy.IsNull() {
+		state.CancelOnDestroy = types.BoolValue(false)
 	}
-	job := jobs[0]
-	state.ID = types.StringValue(job.GetUuid())
 
-	diags := i.syncResourceModelWithSDK(&state, job.GetPayload(), ctx)
+	diags := i.syncResourceModelWithSDK(&state, payload, ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -404,62 +664,60 @@ func (i *installationResource) Read(
 }
 
 func (i *installationResource) Update(
-	_ context.Context,
-	_ resource.UpdateRequest,
-	_ *resource.UpdateResponse,
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
 ) {
+	var plan installationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state installationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All other attributes require a new installation.
+	state.WaitForCompletion = plan.WaitForCompletion
+	state.CancelOnDestroy = plan.CancelOnDestroy
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (i *installationResource) Delete(
-	_ context.Context,
-	_ resource.DeleteRequest,
-	_ *resource.DeleteResponse,
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
 ) {
-}
+	var state installationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.CancelOnDestroy.ValueBool() {
+		return
+	}
 
-func (i *installationResource) getJob(serverID, jobID string, ctx context.Context, resp *resource.CreateResponse) *dedicatedserver.CurrentJob {
-	// Fetch the job
-	result, response, err := i.DedicatedserverAPI.GetJob(ctx, serverID, jobID).Execute()
+	serverID := state.DedicatedServerID.ValueString()
+	job, response, err := i.DedicatedserverAPI.GetJob(ctx, serverID, state.ID.ValueString()).Execute()
 	if err != nil {
 		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
 	}
 
-	return result
-}
-
-// waitForJobAndRetrieveUntilFinished handles polling with retry and timeout.
-func (i *installationResource) waitForJobAndRetrieveUntilFinished(serverID, jobID string, ctx context.Context, resp *resource.CreateResponse) (*dedicatedserver.CurrentJob, error) {
-	var job *dedicatedserver.CurrentJob
-	// Create a constant backoff with a 30-second retry interval
-	bo := backoff.NewConstantBackOff(30 * time.Second)
-
-	// Set the retry limit to 120 retries (60 minutes total)
-	retryCount := 0
-	maxRetries := 120
-
-	// Start polling and retrying
-	for {
-		if retryCount >= maxRetries {
-			return nil, errors.New("timed out waiting for job to finish after 60 minutes")
-		}
-
-		// Call the function to get job
-		job = i.getJob(serverID, jobID, ctx, resp)
-
-		// check if the job is finished
-		if job.GetStatus() == "FINISHED" {
-			// Job is finished, exit the loop
-			return job, nil
-		}
-
-		if job.GetStatus() == "FAILED" {
-			// Job has failed, return an error
-			return nil, fmt.Errorf("job %s for server %s has failed or was canceled", jobID, serverID)
-		}
+	// Only a pending installation is canceled, as the active job of the
+	// server might belong to something else.
+	if job.GetStatus() != "ACTIVE" {
+		return
+	}
 
-		// Sleep for the backoff interval before retrying
-		time.Sleep(bo.NextBackOff())
-		retryCount++
+	_, response, err = i.DedicatedserverAPI.CancelActiveJob(ctx, serverID).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
 	}
 }
 
@@ -475,11 +733,7 @@ func (i *installationResource) syncResourceModelWithSDK(
 	state.Timezone = types.StringValue(payload.GetTimezone())
 	state.Hostname = types.StringValue(payload.GetHostname())
 
-	partitionAttributeTypes := map[string]attr.Type{
-		"filesystem": types.StringType,
-		"mountpoint": types.StringType,
-		"size":       types.StringType,
-	}
+	partitionAttributeTypes := partitionsResourceModel{}.attributeTypes()
 
 	// Preparing and converting partitions into types.Object to store in the state
 	var partitionsObjects []attr.Value
@@ -521,3 +775,139 @@ func (i *installationResource) syncResourceModelWithSDK(
 
 	return diags
 }
+
+// validatePartitions checks that only one partition takes the remaining space.
+func validatePartitions(
+	partitions []partitionsResourceModel,
+	diags *diag.Diagnostics,
+) {
+	var remainingSpacePartitions []string
+
+	for index, partition := range partitions {
+		if partition.Size.ValueString() == remainingPartitionSize {
+			remainingSpacePartitions = append(
+				remainingSpacePartitions,
+				fmt.Sprintf("partitions[%d]", index),
+			)
+		}
+	}
+
+	if len(remainingSpacePartitions) > 1 {
+		diags.AddAttributeError(
+			path.Root("partitions"),
+			"Multiple partitions use the remaining space",
+			fmt.Sprintf(
+				"Only one partition can have size %q, got: %s.",
+				remainingPartitionSize,
+				strings.Join(remainingSpacePartitions, ", "),
+			),
+		)
+	}
+}
+
+// validateRaid checks that enough disks are available for the RAID level.
+func validateRaid(raid raidResourceModel, diags *diag.Diagnostics) {
+	if raid.Type.ValueString() == "NONE" {
+		return
+	}
+
+	if raid.Level.IsNull() || raid.Level.IsUnknown() || raid.NumberOfDisks.IsNull() || raid.NumberOfDisks.IsUnknown() {
+		return
+	}
+
+	level := raid.Level.ValueInt32()
+	numberOfDisks := raid.NumberOfDisks.ValueInt32()
+
+	minimumDisks, ok := raidMinimumDisks[level]
+	if !ok {
+		return
+	}
+
+	if numberOfDisks < minimumDisks {
+		diags.AddAttributeError(
+			path.Root("raid").AtName("number_of_disks"),
+			"Not enough disks for RAID level",
+			fmt.Sprintf(
+				"RAID level %d requires at least %d disks, got %d.",
+				level,
+				minimumDisks,
+				numberOfDisks,
+			),
+		)
+		return
+	}
+
+	if level == 10 && numberOfDisks%2 != 0 {
+		diags.AddAttributeError(
+			path.Root("raid").AtName("number_of_disks"),
+			"Invalid number of disks for RAID level",
+			fmt.Sprintf("RAID level 10 requires an even number of disks, got %d.", numberOfDisks),
+		)
+	}
+}
+
+// validateRootPartition checks that the partitions contain a root partition
+// when the default partitions of the operating system have one.
+func validateRootPartition(
+	partitions []partitionsResourceModel,
+	operatingSystem dedicatedserver.GetOperatingSystemResult,
+	diags *diag.Diagnostics,
+) {
+	defaults := operatingSystem.GetDefaults()
+	if !slices.ContainsFunc(
+		defaults.GetPartitions(),
+		func(partition dedicatedserver.Partition) bool {
+			return partition.GetMountpoint() == rootPartitionKey
+		},
+	) {
+		return
+	}
+
+	for _, partition := range partitions {
+		if partition.Mountpoint.IsUnknown() || partition.Mountpoint.ValueString() == rootPartitionKey {
+			return
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("partitions"),
+		"Missing root partition",
+		fmt.Sprintf(
+			"The partitions must contain a partition with mountpoint %q, as the default partitions of operating system %q do.",
+			rootPartitionKey,
+			operatingSystem.GetId(),
+		),
+	)
+}
+
+// validatePartitionFilesystems checks the partition filesystems against the
+// filesystems supported by the operating system.
+func validatePartitionFilesystems(
+	partitions []partitionsResourceModel,
+	operatingSystem dedicatedserver.GetOperatingSystemResult,
+	diags *diag.Diagnostics,
+) {
+	supportedFilesystems := operatingSystem.GetSupportedFileSystems()
+	if len(supportedFilesystems) == 0 {
+		return
+	}
+
+	for index, partition := range partitions {
+		if partition.Filesystem.IsNull() || partition.Filesystem.IsUnknown() {
+			continue
+		}
+
+		if !slices.Contains(supportedFilesystems, partition.Filesystem.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("partitions").AtListIndex(index).AtName("filesystem"),
+				"Unsupported filesystem",
+				fmt.Sprintf(
+					"Filesystem %q is not supported by operating system %q. Supported filesystems are: %s.",
+					partition.Filesystem.ValueString(),
+					operatingSystem.GetId(),
+					strings.Join(supportedFilesystems, ", "),
+				),
+			)
+		}
+	}
+}
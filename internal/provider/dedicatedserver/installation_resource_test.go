@@ -0,0 +1,353 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatePartitions(t *testing.T) {
+	t.Run("does not set errors for a valid layout", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validatePartitions(
+			[]partitionsResourceModel{
+				{Mountpoint: types.StringValue("/boot"), Size: types.StringValue("1024")},
+				{Mountpoint: types.StringNull(), Size: types.StringValue("4096")},
+				{Mountpoint: types.StringValue("/"), Size: types.StringValue("*")},
+			},
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("sets an error if more than one partition uses the remaining space", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validatePartitions(
+			[]partitionsResourceModel{
+				{Mountpoint: types.StringValue("/"), Size: types.StringValue("*")},
+				{Mountpoint: types.StringValue("/home"), Size: types.StringValue("*")},
+			},
+			&diags,
+		)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Contains(t, diags.Errors()[0].Detail(), "partitions[0], partitions[1]")
+	})
+}
+
+func Test_validateRaid(t *testing.T) {
+	t.Run("does not set errors if enough disks are available", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validateRaid(
+			raidResourceModel{
+				Level:         types.Int32Value(5),
+				NumberOfDisks: types.Int32Value(3),
+				Type:          types.StringValue("SW"),
+			},
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("sets an error if not enough disks are available", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validateRaid(
+			raidResourceModel{
+				Level:         types.Int32Value(10),
+				NumberOfDisks: types.Int32Value(2),
+				Type:          types.StringValue("HW"),
+			},
+			&diags,
+		)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Contains(t, diags.Errors()[0].Detail(), "requires at least 4 disks, got 2")
+	})
+
+	t.Run("sets an error if RAID 10 has an odd number of disks", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validateRaid(
+			raidResourceModel{
+				Level:         types.Int32Value(10),
+				NumberOfDisks: types.Int32Value(5),
+				Type:          types.StringValue("SW"),
+			},
+			&diags,
+		)
+
+		assert.Len(t, diags.Errors(), 1)
+	})
+
+	t.Run("does not set errors if number of disks is not set", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validateRaid(
+			raidResourceModel{
+				Level:         types.Int32Value(1),
+				NumberOfDisks: types.Int32Null(),
+				Type:          types.StringValue("SW"),
+			},
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+}
+
+func Test_validateRootPartition(t *testing.T) {
+	id := "UBUNTU_22_04_64BIT"
+	operatingSystem := dedicatedserver.GetOperatingSystemResult{
+		Id: &id,
+		Defaults: &dedicatedserver.Defaults{
+			Partitions: []dedicatedserver.Partition{
+				{Mountpoint: dedicatedserver.PtrString("/boot"), Size: dedicatedserver.PtrString("1024")},
+				{Mountpoint: dedicatedserver.PtrString("/"), Size: dedicatedserver.PtrString("*")},
+			},
+		},
+	}
+
+	t.Run("does not set errors if the root partition is present", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validateRootPartition(
+			[]partitionsResourceModel{
+				{Mountpoint: types.StringValue("/"), Size: types.StringValue("*")},
+			},
+			operatingSystem,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("sets an error if the root partition is missing", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validateRootPartition(
+			[]partitionsResourceModel{
+				{Mountpoint: types.StringValue("/boot"), Size: types.StringValue("1024")},
+				{Mountpoint: types.StringValue("/home"), Size: types.StringValue("*")},
+			},
+			operatingSystem,
+			&diags,
+		)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Equal(t, "Missing root partition", diags.Errors()[0].Summary())
+	})
+
+	t.Run("does not set errors if a mountpoint is unknown", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validateRootPartition(
+			[]partitionsResourceModel{
+				{Mountpoint: types.StringUnknown(), Size: types.StringValue("*")},
+			},
+			operatingSystem,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("does not set errors if the defaults have no root partition", func(t *testing.T) {
+		windowsID := "WINDOWS_SERVER_2022_STANDARD_64BIT"
+		diags := diag.Diagnostics{}
+		validateRootPartition(
+			[]partitionsResourceModel{
+				{Mountpoint: types.StringValue("C:"), Size: types.StringValue("*")},
+			},
+			dedicatedserver.GetOperatingSystemResult{
+				Id: &windowsID,
+				Defaults: &dedicatedserver.Defaults{
+					Partitions: []dedicatedserver.Partition{
+						{Mountpoint: dedicatedserver.PtrString("C:"), Size: dedicatedserver.PtrString("*")},
+					},
+				},
+			},
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+}
+
+func Test_validatePartitionFilesystems(t *testing.T) {
+	id := "UBUNTU_22_04_64BIT"
+	operatingSystem := dedicatedserver.GetOperatingSystemResult{
+		Id:                   &id,
+		SupportedFileSystems: []string{"ext2", "ext4", "swap"},
+	}
+
+	t.Run("does not set errors for supported filesystems", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validatePartitionFilesystems(
+			[]partitionsResourceModel{
+				{Filesystem: types.StringValue("ext4")},
+				{Filesystem: types.StringNull()},
+			},
+			operatingSystem,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("sets an error for unsupported filesystems", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		validatePartitionFilesystems(
+			[]partitionsResourceModel{
+				{Filesystem: types.StringValue("ext4")},
+				{Filesystem: types.StringValue("zfs")},
+			},
+			operatingSystem,
+			&diags,
+		)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Contains(t, diags.Errors()[0].Detail(), `Filesystem "zfs" is not supported`)
+	})
+}
+
+// installationJobsAPI fakes the job endpoints of the dedicated server API
+// that are used to detect installations made outside of Terraform.
+type installationJobsAPI struct {
+	dedicatedserver.DedicatedserverAPI
+	installation   dedicatedserver.CurrentJob
+	notFound       bool
+	latestJobs     []dedicatedserver.ServerJob
+	jobListFetched bool
+}
+
+func (i *installationJobsAPI) GetJob(_ context.Context, _ string, _ string) dedicatedserver.ApiGetJobRequest {
+	return dedicatedserver.ApiGetJobRequest{ApiService: i}
+}
+
+func (i *installationJobsAPI) GetJobExecute(_ dedicatedserver.ApiGetJobRequest) (*dedicatedserver.CurrentJob, *http.Response, error) {
+	if i.notFound {
+		return nil, &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, errors.New("not found")
+	}
+
+	return &i.installation, nil, nil
+}
+
+func (i *installationJobsAPI) GetJobList(_ context.Context, _ string) dedicatedserver.ApiGetJobListRequest {
+	return dedicatedserver.ApiGetJobListRequest{ApiService: i}
+}
+
+func (i *installationJobsAPI) GetJobListExecute(_ dedicatedserver.ApiGetJobListRequest) (*dedicatedserver.JobList, *http.Response, error) {
+	i.jobListFetched = true
+
+	return &dedicatedserver.JobList{Jobs: i.latestJobs}, nil, nil
+}
+
+func Test_findNewerFinishedInstallation(t *testing.T) {
+	olderCreatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := olderCreatedAt.Add(time.Hour)
+	newerCreatedAt := createdAt.Add(time.Hour)
+
+	installation := func(status string) dedicatedserver.CurrentJob {
+		return dedicatedserver.CurrentJob{
+			Uuid:      dedicatedserver.PtrString("current"),
+			Status:    dedicatedserver.PtrString(status),
+			CreatedAt: &createdAt,
+		}
+	}
+	finishedJob := func(id string, createdAt time.Time) dedicatedserver.ServerJob {
+		return dedicatedserver.ServerJob{
+			Uuid:      dedicatedserver.PtrString(id),
+			Status:    dedicatedserver.PtrString("FINISHED"),
+			CreatedAt: &createdAt,
+		}
+	}
+
+	t.Run("returns nil if the latest installation is in the state", func(t *testing.T) {
+		api := &installationJobsAPI{
+			installation: installation("FINISHED"),
+			latestJobs:   []dedicatedserver.ServerJob{finishedJob("current", createdAt)},
+		}
+
+		got, _, err := findNewerFinishedInstallation(context.TODO(), api, "12345", "current")
+
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("returns a newer finished installation", func(t *testing.T) {
+		api := &installationJobsAPI{
+			installation: installation("FINISHED"),
+			latestJobs:   []dedicatedserver.ServerJob{finishedJob("newer", newerCreatedAt)},
+		}
+
+		got, _, err := findNewerFinishedInstallation(context.TODO(), api, "12345", "current")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "newer", got.GetUuid())
+	})
+
+	t.Run("ignores older finished installations", func(t *testing.T) {
+		api := &installationJobsAPI{
+			installation: installation("FINISHED"),
+			latestJobs:   []dedicatedserver.ServerJob{finishedJob("older", olderCreatedAt)},
+		}
+
+		got, _, err := findNewerFinishedInstallation(context.TODO(), api, "12345", "current")
+
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("ignores older installations while the installation has not finished", func(t *testing.T) {
+		for _, status := range []string{"ACTIVE", "FAILED", "CANCELED"} {
+			api := &installationJobsAPI{
+				installation: installation(status),
+				latestJobs:   []dedicatedserver.ServerJob{finishedJob("older", olderCreatedAt)},
+			}
+
+			got, _, err := findNewerFinishedInstallation(context.TODO(), api, "12345", "current")
+
+			assert.NoError(t, err, status)
+			assert.Nil(t, got, status)
+			assert.False(t, api.jobListFetched, status)
+		}
+	})
+
+	t.Run("ignores newer installations that have not finished", func(t *testing.T) {
+		for _, status := range []string{"ACTIVE", "FAILED", "CANCELED"} {
+			newer := finishedJob("newer", newerCreatedAt)
+			newer.Status = dedicatedserver.PtrString(status)
+			api := &installationJobsAPI{
+				installation: installation("FINISHED"),
+				latestJobs:   []dedicatedserver.ServerJob{newer},
+			}
+
+			got, _, err := findNewerFinishedInstallation(context.TODO(), api, "12345", "current")
+
+			assert.NoError(t, err, status)
+			assert.Nil(t, got, status)
+		}
+	})
+
+	t.Run("returns nil if the installation in the state has been purged", func(t *testing.T) {
+		api := &installationJobsAPI{notFound: true}
+
+		got, _, err := findNewerFinishedInstallation(context.TODO(), api, "12345", "current")
+
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("returns nil if there are no installations", func(t *testing.T) {
+		api := &installationJobsAPI{installation: installation("FINISHED")}
+
+		got, _, err := findNewerFinishedInstallation(context.TODO(), api, "12345", "current")
+
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
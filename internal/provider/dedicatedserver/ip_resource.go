@@ -0,0 +1,281 @@
+package dedicatedserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &ipResource{}
+	_ resource.ResourceWithImportState = &ipResource{}
+)
+
+type ipResource struct {
+	utils.ResourceAPI
+}
+
+type ipResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	IP                types.String `tfsdk:"ip"`
+	ReverseLookup     types.String `tfsdk:"reverse_lookup"`
+	NullRouted        types.Bool   `tfsdk:"null_routed"`
+	CIDR              types.String `tfsdk:"cidr"`
+	FloatingIP        types.Bool   `tfsdk:"floating_ip"`
+	Gateway           types.String `tfsdk:"gateway"`
+	MainIP            types.Bool   `tfsdk:"main_ip"`
+	NetworkType       types.String `tfsdk:"network_type"`
+	Version           types.Int32  `tfsdk:"version"`
+}
+
+func (i *ipResourceModel) syncWithSDK(ip dedicatedserver.Ip) {
+	i.ReverseLookup = types.StringValue(ip.GetReverseLookup())
+	i.NullRouted = types.BoolValue(ip.GetNullRouted())
+	i.CIDR = types.StringValue(ip.GetIp())
+	i.FloatingIP = types.BoolValue(ip.GetFloatingIp())
+	i.Gateway = types.StringValue(ip.GetGateway())
+	i.MainIP = types.BoolValue(ip.GetMainIp())
+	i.NetworkType = types.StringValue(string(ip.GetNetworkType()))
+	i.Version = types.Int32Value(ip.GetVersion())
+}
+
+func NewIPResource() resource.Resource {
+	return &ipResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_ip",
+		},
+	}
+}
+
+func (i *ipResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the reverse lookup and null route of an IP assigned to a dedicated server. The IP must already be assigned to the server; destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address, without prefix length.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reverse_lookup": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The reverse lookup associated with the IP.",
+			},
+			"null_routed": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the IP is null routed or not.",
+			},
+			"cidr": schema.StringAttribute{
+				Computed:    true,
+				Description: "The IP address in CIDR notation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"floating_ip": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the IP is a floating IP.",
+			},
+			"gateway": schema.StringAttribute{
+				Computed:    true,
+				Description: "The gateway of the IP.",
+			},
+			"main_ip": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the IP is the main IP of the dedicated server.",
+			},
+			"network_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The network type of the IP.",
+			},
+			"version": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The IP version.",
+			},
+		},
+	}
+}
+
+func (i *ipResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Make sure the IP is assigned to the server before changing it.
+	ip, response, err := i.DedicatedserverAPI.GetIp(
+		ctx,
+		plan.DedicatedServerID.ValueString(),
+		plan.IP.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state := ipResourceModel{
+		DedicatedServerID: plan.DedicatedServerID,
+		IP:                plan.IP,
+	}
+	state.syncWithSDK(*ip)
+
+	i.update(ctx, plan, &state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (i *ipResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, response, err := i.DedicatedserverAPI.GetIp(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+		state.IP.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state.syncWithSDK(*ip)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (i *ipResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state ipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	i.update(ctx, plan, &state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (i *ipResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+func (i *ipResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		utils.UnexpectedImportIdentifierError(
+			&resp.Diagnostics,
+			"dedicated_server_id,ip",
+			req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("dedicated_server_id"),
+		idParts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("ip"),
+		idParts[1],
+	)...)
+}
+
+// update applies the reverse lookup and null route of the plan that differ
+// from the state. The state is updated after every successful call, so a
+// partial failure is still recorded.
+func (i *ipResource) update(
+	ctx context.Context,
+	plan ipResourceModel,
+	state *ipResourceModel,
+	diags *diag.Diagnostics,
+) {
+	serverID := plan.DedicatedServerID.ValueString()
+	ipAddress := plan.IP.ValueString()
+
+	if !plan.ReverseLookup.IsNull() && !plan.ReverseLookup.IsUnknown() && !plan.ReverseLookup.Equal(state.ReverseLookup) {
+		opts := dedicatedserver.NewUpdateIpProfileOpts()
+		opts.ReverseLookup = plan.ReverseLookup.ValueStringPointer()
+		_, response, err := i.DedicatedserverAPI.UpdateIpProfile(
+			ctx,
+			serverID,
+			ipAddress,
+		).UpdateIpProfileOpts(*opts).Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, response)
+			return
+		}
+		state.ReverseLookup = plan.ReverseLookup
+	}
+
+	if !plan.NullRouted.IsNull() && !plan.NullRouted.IsUnknown() && !plan.NullRouted.Equal(state.NullRouted) {
+		if plan.NullRouted.ValueBool() {
+			_, response, err := i.DedicatedserverAPI.NullIpRoute(
+				ctx,
+				serverID,
+				ipAddress,
+			).Execute()
+			if err != nil {
+				utils.SdkError(ctx, diags, err, response)
+				return
+			}
+		} else {
+			_, response, err := i.DedicatedserverAPI.RemoveNullIpRoute(
+				ctx,
+				serverID,
+				ipAddress,
+			).Execute()
+			if err != nil {
+				utils.SdkError(ctx, diags, err, response)
+				return
+			}
+		}
+		state.NullRouted = plan.NullRouted
+	}
+}
@@ -0,0 +1,34 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+func NewIPMIResetResource() resource.Resource {
+	return &triggeredJobResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_ipmi_reset",
+		},
+		description:           "Resets the IPMI interface of a dedicated server and waits for the reset to finish. A reset makes sure the IPMI interface is compatible with Leaseweb automation and requires a reboot of the server.",
+		jobName:               "IPMI reset",
+		powerCycleDescription: "If true, the server is power cycled in order to complete the IPMI reset.",
+		startJob: func(
+			ctx context.Context,
+			api dedicatedserver.DedicatedserverAPI,
+			request triggeredJobRequest,
+		) (*dedicatedserver.Job, *http.Response, error) {
+			opts := dedicatedserver.NewIpmiResetOpts()
+			opts.CallbackUrl = request.callbackURL
+			opts.PowerCycle = request.powerCycle
+
+			return api.IpmiReset(ctx, request.serverID).
+				IpmiResetOpts(*opts).
+				Execute()
+		},
+	}
+}
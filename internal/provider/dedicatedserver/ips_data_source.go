@@ -0,0 +1,177 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &ipsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ipsDataSource{}
+)
+
+type ipsDataSource struct {
+	utils.DataSourceAPI
+}
+
+type ipDataSourceModel struct {
+	IP                   types.String `tfsdk:"ip"`
+	CIDR                 types.String `tfsdk:"cidr"`
+	DDoSDetectionProfile types.String `tfsdk:"ddos_detection_profile"`
+	DDoSProtectionType   types.String `tfsdk:"ddos_protection_type"`
+	FloatingIP           types.Bool   `tfsdk:"floating_ip"`
+	Gateway              types.String `tfsdk:"gateway"`
+	MainIP               types.Bool   `tfsdk:"main_ip"`
+	NetworkType          types.String `tfsdk:"network_type"`
+	NullRouted           types.Bool   `tfsdk:"null_routed"`
+	ReverseLookup        types.String `tfsdk:"reverse_lookup"`
+	Version              types.Int32  `tfsdk:"version"`
+}
+
+type ipsDataSourceModel struct {
+	DedicatedServerID types.String        `tfsdk:"dedicated_server_id"`
+	IPs               []ipDataSourceModel `tfsdk:"ips"`
+}
+
+func adaptIPToIPDataSource(ip dedicatedserver.Ip) ipDataSourceModel {
+	ddos := ip.GetDdos()
+	detectionProfile, _ := ddos.GetDetectionProfileOk()
+	protectionType, _ := ddos.GetProtectionTypeOk()
+
+	return ipDataSourceModel{
+		IP:                   basetypes.NewStringValue(strings.Split(ip.GetIp(), "/")[0]),
+		CIDR:                 basetypes.NewStringValue(ip.GetIp()),
+		DDoSDetectionProfile: basetypes.NewStringPointerValue(detectionProfile),
+		DDoSProtectionType:   basetypes.NewStringPointerValue(protectionType),
+		FloatingIP:           basetypes.NewBoolValue(ip.GetFloatingIp()),
+		Gateway:              basetypes.NewStringValue(ip.GetGateway()),
+		MainIP:               basetypes.NewBoolValue(ip.GetMainIp()),
+		NetworkType:          basetypes.NewStringValue(string(ip.GetNetworkType())),
+		NullRouted:           basetypes.NewBoolValue(ip.GetNullRouted()),
+		ReverseLookup:        basetypes.NewStringValue(ip.GetReverseLookup()),
+		Version:              basetypes.NewInt32Value(ip.GetVersion()),
+	}
+}
+
+func NewIPsDataSource() datasource.DataSource {
+	return &ipsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_ips",
+		},
+	}
+}
+
+func (i *ipsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the IPs assigned to a dedicated server.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+			},
+			"ips": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The IPs assigned to the dedicated server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IP address, without prefix length.",
+						},
+						"cidr": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IP address in CIDR notation.",
+						},
+						"ddos_detection_profile": schema.StringAttribute{
+							Computed:    true,
+							Description: "The applied DDoS detection profile.",
+						},
+						"ddos_protection_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of DDoS protection.",
+						},
+						"floating_ip": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the IP is a floating IP.",
+						},
+						"gateway": schema.StringAttribute{
+							Computed:    true,
+							Description: "The gateway of the IP.",
+						},
+						"main_ip": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the IP is the main IP of the dedicated server.",
+						},
+						"network_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The network type of the IP.",
+						},
+						"null_routed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the IP is null routed or not.",
+						},
+						"reverse_lookup": schema.StringAttribute{
+							Computed:    true,
+							Description: "The reverse lookup associated with the IP.",
+						},
+						"version": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The IP version.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (i *ipsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config ipsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := ipsDataSourceModel{
+		DedicatedServerID: config.DedicatedServerID,
+		IPs:               []ipDataSourceModel{},
+	}
+
+	request := i.DedicatedserverAPI.GetIpList(ctx, config.DedicatedServerID.ValueString())
+	ips, response, err := utils.GetAllPages(
+		func(offset int32) (*utils.Page[dedicatedserver.Ip], *http.Response, error) {
+			result, response, err := request.Limit(pageLimit).Offset(offset).Execute()
+			if err != nil {
+				return nil, response, err
+			}
+
+			return newPage(result.GetIps(), result.GetMetadata()), nil, nil
+		},
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	for _, ip := range ips {
+		state.IPs = append(state.IPs, adaptIPToIPDataSource(ip))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
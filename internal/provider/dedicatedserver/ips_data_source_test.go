@@ -0,0 +1,49 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptIPToIPDataSource(t *testing.T) {
+	networkType := dedicatedserver.NETWORKTYPE_PUBLIC
+
+	got := adaptIPToIPDataSource(dedicatedserver.Ip{
+		Ddos: &dedicatedserver.DDos{
+			DetectionProfile: dedicatedserver.PtrString("ADVANCED_LOW_UDP"),
+			ProtectionType:   dedicatedserver.PtrString("ADVANCED"),
+		},
+		FloatingIp:    dedicatedserver.PtrBool(false),
+		Gateway:       dedicatedserver.PtrString("12.123.123.254"),
+		Ip:            dedicatedserver.PtrString("12.123.123.1/24"),
+		MainIp:        dedicatedserver.PtrBool(true),
+		NetworkType:   &networkType,
+		NullRouted:    dedicatedserver.PtrBool(true),
+		ReverseLookup: dedicatedserver.PtrString("domain.example.com"),
+		Version:       dedicatedserver.PtrInt32(4),
+	})
+
+	assert.Equal(t, "12.123.123.1", got.IP.ValueString())
+	assert.Equal(t, "12.123.123.1/24", got.CIDR.ValueString())
+	assert.Equal(t, "ADVANCED_LOW_UDP", got.DDoSDetectionProfile.ValueString())
+	assert.Equal(t, "ADVANCED", got.DDoSProtectionType.ValueString())
+	assert.False(t, got.FloatingIP.ValueBool())
+	assert.Equal(t, "12.123.123.254", got.Gateway.ValueString())
+	assert.True(t, got.MainIP.ValueBool())
+	assert.Equal(t, "PUBLIC", got.NetworkType.ValueString())
+	assert.True(t, got.NullRouted.ValueBool())
+	assert.Equal(t, "domain.example.com", got.ReverseLookup.ValueString())
+	assert.Equal(t, int32(4), got.Version.ValueInt32())
+}
+
+func Test_adaptIPToIPDataSource_withoutDDoS(t *testing.T) {
+	got := adaptIPToIPDataSource(dedicatedserver.Ip{
+		Ip: dedicatedserver.PtrString("2001:db8:85a3::8a2e:370:7334/64"),
+	})
+
+	assert.Equal(t, "2001:db8:85a3::8a2e:370:7334", got.IP.ValueString())
+	assert.True(t, got.DDoSDetectionProfile.IsNull())
+	assert.True(t, got.DDoSProtectionType.IsNull())
+}
@@ -0,0 +1,147 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+)
+
+// waitForJobAndRetrieveUntilFinished handles polling with retry and timeout.
+// The active job is canceled when the timeout is reached or ctx is canceled,
+// so that the job does not keep running on the server. Polling stops at the
+// first error returned by the API, the response is returned with it.
+func waitForJobAndRetrieveUntilFinished(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	jobID string,
+) (*dedicatedserver.CurrentJob, *http.Response, error) {
+	// Poll every 30 seconds for 60 minutes
+	job, response, err := waitUntil(
+		ctx,
+		30*time.Second,
+		120,
+		"job to finish",
+		func() (*dedicatedserver.CurrentJob, bool, *http.Response, error) {
+			job, response, err := api.GetJob(ctx, serverID, jobID).Execute()
+			if err != nil {
+				return nil, false, response, err
+			}
+			logJobProgress(ctx, job)
+
+			switch job.GetStatus() {
+			case "FINISHED":
+				return job, true, nil, nil
+			case "FAILED":
+				return nil, false, nil, jobFailedError(serverID, job)
+			case "CANCELED":
+				return nil, false, nil, fmt.Errorf("job %s for server %s was canceled", jobID, serverID)
+			}
+
+			return job, false, nil, nil
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errWaitTimedOut) || ctx.Err() != nil {
+			return nil, nil, cancelActiveJob(ctx, api, serverID, jobID, err)
+		}
+
+		return nil, response, err
+	}
+
+	return job, nil, nil
+}
+
+// cancelActiveJob cancels the active job of the server and returns cause
+// together with the outcome of the cancellation.
+func cancelActiveJob(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	jobID string,
+	cause error,
+) error {
+	// ctx may already be canceled, the cancellation must still reach the API.
+	_, _, err := api.CancelActiveJob(
+		context.WithoutCancel(ctx),
+		serverID,
+	).Execute()
+	if err != nil {
+		return fmt.Errorf("%w, canceling job %s for server %s failed: %w", cause, jobID, serverID, err)
+	}
+
+	return fmt.Errorf("%w, job %s for server %s has been canceled", cause, jobID, serverID)
+}
+
+// findJobTask returns the first task of the job with the given status.
+func findJobTask(job *dedicatedserver.CurrentJob, status string) *dedicatedserver.Task {
+	for _, task := range job.GetTasks() {
+		if task.GetStatus() == status {
+			return &task
+		}
+	}
+
+	return nil
+}
+
+// logJobProgress logs the progress of the job, so that long-running
+// installations are not only reported as "Still creating...".
+func logJobProgress(ctx context.Context, job *dedicatedserver.CurrentJob) {
+	if job == nil {
+		return
+	}
+
+	progress := job.GetProgress()
+	fields := map[string]any{
+		"job_id":     job.GetUuid(),
+		"status":     job.GetStatus(),
+		"percentage": progress.GetPercentage(),
+		"finished":   progress.GetFinished(),
+		"total":      progress.GetTotal(),
+	}
+	if task := findJobTask(job, "ACTIVE"); task != nil {
+		fields["current_task"] = task.GetDescription()
+	}
+	if task := findJobTask(job, "FAILED"); task != nil {
+		fields["failed_task"] = task.GetDescription()
+		fields["error_message"] = task.GetErrorMessage()
+	}
+
+	tflog.Info(
+		ctx,
+		fmt.Sprintf(
+			"Job %s is %s, %d%% completed",
+			job.GetUuid(),
+			job.GetStatus(),
+			progress.GetPercentage(),
+		),
+		fields,
+	)
+}
+
+// jobFailedError describes which task of a failed job went wrong.
+func jobFailedError(serverID string, job *dedicatedserver.CurrentJob) error {
+	task := findJobTask(job, "FAILED")
+	if task == nil {
+		return fmt.Errorf("job %s for server %s has failed", job.GetUuid(), serverID)
+	}
+
+	errorMessage := task.GetErrorMessage()
+	if errorMessage == "" {
+		errorMessage = "no error message was returned"
+	}
+
+	return fmt.Errorf(
+		"job %s for server %s has failed at task %q (%s): %s",
+		job.GetUuid(),
+		serverID,
+		task.GetDescription(),
+		task.GetUuid(),
+		errorMessage,
+	)
+}
@@ -0,0 +1,265 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &jobDataSource{}
+	_ datasource.DataSourceWithConfigure = &jobDataSource{}
+)
+
+type jobDataSource struct {
+	utils.DataSourceAPI
+}
+
+type jobProgressDataSourceModel struct {
+	Canceled   types.Int32 `tfsdk:"canceled"`
+	Expired    types.Int32 `tfsdk:"expired"`
+	Failed     types.Int32 `tfsdk:"failed"`
+	Finished   types.Int32 `tfsdk:"finished"`
+	InProgress types.Int32 `tfsdk:"in_progress"`
+	Pending    types.Int32 `tfsdk:"pending"`
+	Percentage types.Int32 `tfsdk:"percentage"`
+	Total      types.Int32 `tfsdk:"total"`
+	Waiting    types.Int32 `tfsdk:"waiting"`
+}
+
+type jobTaskDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+	Flow         types.String `tfsdk:"flow"`
+	OnError      types.String `tfsdk:"on_error"`
+	Status       types.String `tfsdk:"status"`
+}
+
+type jobDataSourceModel struct {
+	ID                types.String                `tfsdk:"id"`
+	DedicatedServerID types.String                `tfsdk:"dedicated_server_id"`
+	CreatedAt         types.String                `tfsdk:"created_at"`
+	Flow              types.String                `tfsdk:"flow"`
+	IsRunning         types.Bool                  `tfsdk:"is_running"`
+	Node              types.String                `tfsdk:"node"`
+	Progress          *jobProgressDataSourceModel `tfsdk:"progress"`
+	Status            types.String                `tfsdk:"status"`
+	Tasks             []jobTaskDataSourceModel    `tfsdk:"tasks"`
+	Type              types.String                `tfsdk:"type"`
+	UpdatedAt         types.String                `tfsdk:"updated_at"`
+}
+
+func adaptCurrentJobToJobDataSource(
+	job dedicatedserver.CurrentJob,
+	dedicatedServerID types.String,
+) jobDataSourceModel {
+	var progress *jobProgressDataSourceModel
+	if sdkProgress, ok := job.GetProgressOk(); ok {
+		progress = &jobProgressDataSourceModel{
+			Canceled:   basetypes.NewInt32Value(sdkProgress.GetCanceled()),
+			Expired:    basetypes.NewInt32Value(sdkProgress.GetExpired()),
+			Failed:     basetypes.NewInt32Value(sdkProgress.GetFailed()),
+			Finished:   basetypes.NewInt32Value(sdkProgress.GetFinished()),
+			InProgress: basetypes.NewInt32Value(sdkProgress.GetInprogress()),
+			Pending:    basetypes.NewInt32Value(sdkProgress.GetPending()),
+			Percentage: basetypes.NewInt32Value(sdkProgress.GetPercentage()),
+			Total:      basetypes.NewInt32Value(sdkProgress.GetTotal()),
+			Waiting:    basetypes.NewInt32Value(sdkProgress.GetWaiting()),
+		}
+	}
+
+	var tasks []jobTaskDataSourceModel
+	for _, task := range job.GetTasks() {
+		errorMessage, _ := task.GetErrorMessageOk()
+		tasks = append(tasks, jobTaskDataSourceModel{
+			ID:           basetypes.NewStringValue(task.GetUuid()),
+			Description:  basetypes.NewStringValue(task.GetDescription()),
+			ErrorMessage: basetypes.NewStringPointerValue(errorMessage),
+			Flow:         basetypes.NewStringValue(task.GetFlow()),
+			OnError:      basetypes.NewStringValue(task.GetOnError()),
+			Status:       basetypes.NewStringValue(task.GetStatus()),
+		})
+	}
+
+	createdAt, _ := job.GetCreatedAtOk()
+	updatedAt, _ := job.GetUpdatedAtOk()
+
+	return jobDataSourceModel{
+		ID:                basetypes.NewStringValue(job.GetUuid()),
+		DedicatedServerID: dedicatedServerID,
+		CreatedAt:         utils.AdaptNullableTimeToStringValue(createdAt),
+		Flow:              basetypes.NewStringValue(job.GetFlow()),
+		IsRunning:         basetypes.NewBoolValue(job.GetIsRunning()),
+		Node:              basetypes.NewStringValue(job.GetNode()),
+		Progress:          progress,
+		Status:            basetypes.NewStringValue(job.GetStatus()),
+		Tasks:             tasks,
+		Type:              basetypes.NewStringValue(string(job.GetType())),
+		UpdatedAt:         utils.AdaptNullableTimeToStringValue(updatedAt),
+	}
+}
+
+func (j *jobDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Inspect the status and progress of a dedicated server job",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the job.",
+			},
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation timestamp.",
+			},
+			"flow": schema.StringAttribute{
+				Computed:    true,
+				Description: "The flow of the job.",
+			},
+			"is_running": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the job is running.",
+			},
+			"node": schema.StringAttribute{
+				Computed:    true,
+				Description: "The node which executes the job.",
+			},
+			"progress": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The number of tasks per status.",
+				Attributes: map[string]schema.Attribute{
+					"canceled": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of canceled tasks.",
+					},
+					"expired": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of expired tasks.",
+					},
+					"failed": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of failed tasks.",
+					},
+					"finished": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of finished tasks.",
+					},
+					"in_progress": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of tasks in progress.",
+					},
+					"pending": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of pending tasks.",
+					},
+					"percentage": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Percentage of tasks that have been completed.",
+					},
+					"total": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Total number of tasks.",
+					},
+					"waiting": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of waiting tasks.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the job, i.e.: *ACTIVE*, *FINISHED*, *FAILED*, *CANCELED* or *EXPIRED*.",
+			},
+			"tasks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The tasks of the job.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the task.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the task.",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "The error message of the task, if it has failed.",
+						},
+						"flow": schema.StringAttribute{
+							Computed:    true,
+							Description: "The flow of the task.",
+						},
+						"on_error": schema.StringAttribute{
+							Computed:    true,
+							Description: "The action taken when the task fails.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the task.",
+						},
+					},
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the job.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Update timestamp.",
+			},
+		},
+	}
+}
+
+func (j *jobDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config jobDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, response, err := j.DedicatedserverAPI.GetJob(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+		config.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(
+			ctx,
+			adaptCurrentJobToJobDataSource(*job, config.DedicatedServerID),
+		)...,
+	)
+}
+
+func NewJobDataSource() datasource.DataSource {
+	return &jobDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_job",
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package dedicatedserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptCurrentJobToJobDataSource(t *testing.T) {
+	createdAt, _ := time.Parse("2006-01-02 15:04:05", "2023-12-14 17:09:47")
+	uuid := "bcf2bedf-8450-4b22-86a8-f30aeb3a38f9"
+	status := "ACTIVE"
+	isRunning := true
+	jobType := dedicatedserver.JOBTYPE_INSTALL
+	percentage := int32(50)
+	total := int32(4)
+	taskDescription := "dummy"
+	taskStatus := "FAILED"
+	errorMessage := "the task has failed"
+
+	job := dedicatedserver.CurrentJob{
+		Uuid:      &uuid,
+		Status:    &status,
+		IsRunning: &isRunning,
+		Type:      &jobType,
+		CreatedAt: &createdAt,
+		Progress: &dedicatedserver.Progress{
+			Percentage: &percentage,
+			Total:      &total,
+		},
+		Tasks: []dedicatedserver.Task{
+			{
+				Description:  &taskDescription,
+				Status:       &taskStatus,
+				ErrorMessage: *dedicatedserver.NewNullableString(&errorMessage),
+			},
+		},
+	}
+
+	got := adaptCurrentJobToJobDataSource(job, basetypes.NewStringValue("12345"))
+
+	assert.Equal(t, uuid, got.ID.ValueString())
+	assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+	assert.Equal(t, "ACTIVE", got.Status.ValueString())
+	assert.True(t, got.IsRunning.ValueBool())
+	assert.Equal(t, "install", got.Type.ValueString())
+	assert.Equal(t, "2023-12-14 17:09:47 +0000 UTC", got.CreatedAt.ValueString())
+	assert.True(t, got.UpdatedAt.IsNull())
+
+	require.NotNil(t, got.Progress)
+	assert.Equal(t, int32(50), got.Progress.Percentage.ValueInt32())
+	assert.Equal(t, int32(4), got.Progress.Total.ValueInt32())
+
+	require.Len(t, got.Tasks, 1)
+	assert.Equal(t, "dummy", got.Tasks[0].Description.ValueString())
+	assert.Equal(t, "FAILED", got.Tasks[0].Status.ValueString())
+	assert.Equal(t, "the task has failed", got.Tasks[0].ErrorMessage.ValueString())
+}
@@ -0,0 +1,136 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// jobAPI fakes the job endpoints of the dedicated server API.
+type jobAPI struct {
+	dedicatedserver.DedicatedserverAPI
+	calls         int
+	err           error
+	errStatusCode int
+	cancel        context.CancelFunc
+	cancelCalls   int
+}
+
+func (j *jobAPI) GetJob(_ context.Context, _ string, _ string) dedicatedserver.ApiGetJobRequest {
+	return dedicatedserver.ApiGetJobRequest{ApiService: j}
+}
+
+func (j *jobAPI) GetJobExecute(_ dedicatedserver.ApiGetJobRequest) (*dedicatedserver.CurrentJob, *http.Response, error) {
+	j.calls++
+	if j.cancel != nil {
+		// The context is canceled while the request is in flight.
+		j.cancel()
+		return nil, nil, context.Canceled
+	}
+	if j.err != nil {
+		statusCode := j.errStatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusInternalServerError
+		}
+
+		return nil, &http.Response{StatusCode: statusCode, Body: http.NoBody}, j.err
+	}
+
+	finished := "FINISHED"
+	return &dedicatedserver.CurrentJob{Status: &finished}, nil, nil
+}
+
+func (j *jobAPI) CancelActiveJob(_ context.Context, _ string) dedicatedserver.ApiCancelActiveJobRequest {
+	return dedicatedserver.ApiCancelActiveJobRequest{ApiService: j}
+}
+
+func (j *jobAPI) CancelActiveJobExecute(_ dedicatedserver.ApiCancelActiveJobRequest) (*dedicatedserver.Job, *http.Response, error) {
+	j.cancelCalls++
+
+	return &dedicatedserver.Job{}, nil, nil
+}
+
+func Test_waitForJobAndRetrieveUntilFinished(t *testing.T) {
+	t.Run("returns the finished job", func(t *testing.T) {
+		api := &jobAPI{}
+
+		job, _, err := waitForJobAndRetrieveUntilFinished(context.TODO(), api, "12345", "jobId")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "FINISHED", job.GetStatus())
+		assert.Equal(t, 1, api.calls)
+	})
+
+	t.Run("stops polling at the first API error", func(t *testing.T) {
+		api := &jobAPI{err: errors.New("internal server error")}
+
+		job, response, err := waitForJobAndRetrieveUntilFinished(context.TODO(), api, "12345", "jobId")
+
+		assert.Nil(t, job)
+		assert.EqualError(t, err, "internal server error")
+		assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+		assert.Equal(t, 1, api.calls)
+		assert.Equal(t, 0, api.cancelCalls)
+	})
+
+	t.Run("cancels the job if ctx is canceled during a request", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		api := &jobAPI{cancel: cancel}
+
+		job, _, err := waitForJobAndRetrieveUntilFinished(ctx, api, "12345", "jobId")
+
+		assert.Nil(t, job)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorContains(t, err, "job jobId for server 12345 has been canceled")
+		assert.Equal(t, 1, api.cancelCalls)
+	})
+}
+
+func Test_jobFailedError(t *testing.T) {
+	jobID := "c8d1c3a9-1f0a-4b36-9e06-2a0f4b4a2e1f"
+
+	t.Run("contains the failed task and its error message", func(t *testing.T) {
+		finished := "FINISHED"
+		failed := "FAILED"
+		description := "Partition disks"
+		taskID := "3f6b8e5d-2c4b-4d3e-8a6f-9b1c0d2e4f5a"
+		errorMessage := "disk /dev/sda not found"
+		job := dedicatedserver.CurrentJob{
+			Uuid: &jobID,
+			Tasks: []dedicatedserver.Task{
+				{Status: &finished},
+				{
+					Description:  &description,
+					ErrorMessage: *dedicatedserver.NewNullableString(&errorMessage),
+					Status:       &failed,
+					Uuid:         &taskID,
+				},
+			},
+		}
+
+		err := jobFailedError("12345", &job)
+
+		assert.EqualError(
+			t,
+			err,
+			`job c8d1c3a9-1f0a-4b36-9e06-2a0f4b4a2e1f for server 12345 has failed at task "Partition disks" (3f6b8e5d-2c4b-4d3e-8a6f-9b1c0d2e4f5a): disk /dev/sda not found`,
+		)
+	})
+
+	t.Run("falls back to a generic error without a failed task", func(t *testing.T) {
+		job := dedicatedserver.CurrentJob{Uuid: &jobID}
+
+		err := jobFailedError("12345", &job)
+
+		assert.EqualError(
+			t,
+			err,
+			"job c8d1c3a9-1f0a-4b36-9e06-2a0f4b4a2e1f for server 12345 has failed",
+		)
+	})
+}
@@ -0,0 +1,267 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &metricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &metricsDataSource{}
+)
+
+// metricsRequest contains the query parameters of a metrics request.
+type metricsRequest struct {
+	serverID    string
+	from        time.Time
+	to          time.Time
+	aggregation string
+	granularity *string
+}
+
+// metricsDataSource is shared by the bandwidth and datatraffic metrics data
+// sources, as both endpoints return the same structure.
+type metricsDataSource struct {
+	utils.DataSourceAPI
+	description   string
+	granularities []string
+	aggregations  []string
+	getMetrics    func(
+		ctx context.Context,
+		api dedicatedserver.DedicatedserverAPI,
+		request metricsRequest,
+	) (*dedicatedserver.Metrics, *http.Response, error)
+}
+
+type metricValueDataSourceModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Value     types.Int32  `tfsdk:"value"`
+}
+
+type metricDataSourceModel struct {
+	Unit   types.String                 `tfsdk:"unit"`
+	Values []metricValueDataSourceModel `tfsdk:"values"`
+}
+
+type metricsDataSourceModel struct {
+	DedicatedServerID types.String           `tfsdk:"dedicated_server_id"`
+	From              types.String           `tfsdk:"from"`
+	To                types.String           `tfsdk:"to"`
+	Aggregation       types.String           `tfsdk:"aggregation"`
+	Granularity       types.String           `tfsdk:"granularity"`
+	UpPublic          *metricDataSourceModel `tfsdk:"up_public"`
+	DownPublic        *metricDataSourceModel `tfsdk:"down_public"`
+}
+
+func adaptMetricToMetricDataSource(metric *dedicatedserver.Metric) *metricDataSourceModel {
+	if metric == nil {
+		return nil
+	}
+
+	values := []metricValueDataSourceModel{}
+	for _, value := range metric.GetValues() {
+		timestamp, _ := value.GetTimestampOk()
+		values = append(values, metricValueDataSourceModel{
+			Timestamp: utils.AdaptNullableTimeToStringValue(timestamp),
+			Value:     basetypes.NewInt32Value(value.GetValue()),
+		})
+	}
+
+	return &metricDataSourceModel{
+		Unit:   basetypes.NewStringValue(metric.GetUnit()),
+		Values: values,
+	}
+}
+
+func (m *metricsDataSourceModel) syncWithSDK(metrics dedicatedserver.Metrics) {
+	metricValues := metrics.GetMetrics()
+	m.UpPublic = adaptMetricToMetricDataSource(metricValues.UP_PUBLIC)
+	m.DownPublic = adaptMetricToMetricDataSource(metricValues.DOWN_PUBLIC)
+}
+
+func NewBandwidthMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_bandwidth_metrics",
+		},
+		description:   "Retrieve the bandwidth metrics of the public interface of a dedicated server. Values are expressed in bps.",
+		granularities: []string{"5MIN", "HOUR", "DAY", "WEEK", "MONTH", "YEAR"},
+		aggregations:  []string{"AVG", "95TH"},
+		getMetrics: func(
+			ctx context.Context,
+			api dedicatedserver.DedicatedserverAPI,
+			request metricsRequest,
+		) (*dedicatedserver.Metrics, *http.Response, error) {
+			apiRequest := api.GetBandwidthMetrics(ctx, request.serverID).
+				From(request.from).
+				To(request.to).
+				Aggregation(request.aggregation)
+			if request.granularity != nil {
+				apiRequest = apiRequest.Granularity(*request.granularity)
+			}
+
+			return apiRequest.Execute()
+		},
+	}
+}
+
+func NewDatatrafficMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_datatraffic_metrics",
+		},
+		description:   "Retrieve the datatraffic metrics of the public interface of a dedicated server. Values are expressed in bytes.",
+		granularities: []string{"DAY", "WEEK", "MONTH", "YEAR"},
+		aggregations:  []string{"SUM"},
+		getMetrics: func(
+			ctx context.Context,
+			api dedicatedserver.DedicatedserverAPI,
+			request metricsRequest,
+		) (*dedicatedserver.Metrics, *http.Response, error) {
+			apiRequest := api.GetDataTrafficMetrics(ctx, request.serverID).
+				From(request.from).
+				To(request.to).
+				Aggregation(request.aggregation)
+			if request.granularity != nil {
+				apiRequest = apiRequest.Granularity(*request.granularity)
+			}
+
+			return apiRequest.Execute()
+		},
+	}
+}
+
+func (m *metricsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	rfc3339Validator := stringvalidator.RegexMatches(
+		regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`),
+		"must be specified using the RFC3339 format (`yyyy-mm-ddThh:mm:ssZ`)",
+	)
+
+	metricAttribute := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: description,
+			Attributes: map[string]schema.Attribute{
+				"unit": schema.StringAttribute{
+					Computed:    true,
+					Description: "The unit of the values.",
+				},
+				"values": schema.ListNestedAttribute{
+					Computed:    true,
+					Description: "The values of the metric, one per granularity interval.",
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"timestamp": schema.StringAttribute{
+								Computed:    true,
+								Description: "The start of the interval.",
+							},
+							"value": schema.Int32Attribute{
+								Computed:    true,
+								Description: "The aggregated value of the interval.",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: m.description,
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+			},
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "Start of the interval in RFC3339 format. The returned data includes everything up from - and including - the specified date time.",
+				Validators:  []validator.String{rfc3339Validator},
+			},
+			"to": schema.StringAttribute{
+				Required:    true,
+				Description: "End of the interval in RFC3339 format. The returned data includes everything up until - but not including - the specified date time.",
+				Validators:  []validator.String{rfc3339Validator},
+			},
+			"aggregation": schema.StringAttribute{
+				Required: true,
+				Description: "Aggregate each metric using the given aggregation function. Valid options are " +
+					utils.StringTypeArrayToMarkdown(m.aggregations),
+				Validators: []validator.String{
+					stringvalidator.OneOf(m.aggregations...),
+				},
+			},
+			"granularity": schema.StringAttribute{
+				Optional: true,
+				Description: "The interval of each metric. If omitted, only one value is returned for the whole interval. Valid options are " +
+					utils.StringTypeArrayToMarkdown(m.granularities),
+				Validators: []validator.String{
+					stringvalidator.OneOf(m.granularities...),
+				},
+			},
+			"up_public":   metricAttribute("The outgoing traffic of the public interface."),
+			"down_public": metricAttribute("The incoming traffic of the public interface."),
+		},
+	}
+}
+
+func (m *metricsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config metricsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, err := time.Parse(time.RFC3339, config.From.ValueString())
+	if err != nil {
+		utils.GeneralError(&resp.Diagnostics, ctx, err)
+		return
+	}
+	to, err := time.Parse(time.RFC3339, config.To.ValueString())
+	if err != nil {
+		utils.GeneralError(&resp.Diagnostics, ctx, err)
+		return
+	}
+	if !from.Before(to) {
+		utils.ReportError(
+			fmt.Sprintf("from (%s) must be before to (%s)", config.From.ValueString(), config.To.ValueString()),
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	metrics, response, err := m.getMetrics(ctx, m.DedicatedserverAPI, metricsRequest{
+		serverID:    config.DedicatedServerID.ValueString(),
+		from:        from,
+		to:          to,
+		aggregation: config.Aggregation.ValueString(),
+		granularity: utils.AdaptStringPointerValueToNullableString(config.Granularity),
+	})
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	config.syncWithSDK(*metrics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
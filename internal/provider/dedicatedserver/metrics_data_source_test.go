@@ -0,0 +1,41 @@
+package dedicatedserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_metricsDataSourceModel_syncWithSDK(t *testing.T) {
+	timestamp, _ := time.Parse(time.RFC3339, "2016-10-20T09:00:00Z")
+	unit := "bps"
+	value := int32(202499)
+
+	metrics := dedicatedserver.Metrics{
+		Metrics: &dedicatedserver.MetricValues{
+			DOWN_PUBLIC: &dedicatedserver.Metric{
+				Unit: &unit,
+				Values: []dedicatedserver.MetricValue{
+					{Timestamp: &timestamp, Value: &value},
+				},
+			},
+		},
+	}
+
+	got := metricsDataSourceModel{}
+	got.syncWithSDK(metrics)
+
+	assert.Nil(t, got.UpPublic)
+	require.NotNil(t, got.DownPublic)
+	assert.Equal(t, "bps", got.DownPublic.Unit.ValueString())
+	require.Len(t, got.DownPublic.Values, 1)
+	assert.Equal(
+		t,
+		"2016-10-20 09:00:00 +0000 UTC",
+		got.DownPublic.Values[0].Timestamp.ValueString(),
+	)
+	assert.Equal(t, int32(202499), got.DownPublic.Values[0].Value.ValueInt32())
+}
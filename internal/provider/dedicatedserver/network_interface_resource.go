@@ -0,0 +1,286 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &networkInterfaceResource{}
+	_ resource.ResourceWithImportState = &networkInterfaceResource{}
+)
+
+// networkTypeURLs maps the network interface types to their URL path
+// segments.
+var networkTypeURLs = map[string]dedicatedserver.NetworkTypeURL{
+	string(dedicatedserver.NETWORKTYPE_PUBLIC):            dedicatedserver.NETWORKTYPEURL_PUBLIC,
+	string(dedicatedserver.NETWORKTYPE_INTERNAL):          dedicatedserver.NETWORKTYPEURL_INTERNAL,
+	string(dedicatedserver.NETWORKTYPE_REMOTE_MANAGEMENT): dedicatedserver.NETWORKTYPEURL_REMOTE_MANAGEMENT,
+}
+
+const (
+	networkInterfaceStatusOpen   = "OPEN"
+	networkInterfaceStatusClosed = "CLOSED"
+)
+
+type networkInterfaceResource struct {
+	utils.ResourceAPI
+}
+
+type networkInterfaceResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	Type              types.String `tfsdk:"type"`
+	Opened            types.Bool   `tfsdk:"opened"`
+	LinkSpeed         types.String `tfsdk:"link_speed"`
+	OperStatus        types.String `tfsdk:"oper_status"`
+	Status            types.String `tfsdk:"status"`
+	SwitchInterface   types.String `tfsdk:"switch_interface"`
+	SwitchName        types.String `tfsdk:"switch_name"`
+}
+
+func (n *networkInterfaceResourceModel) syncWithSDK(
+	networkInterface dedicatedserver.OperationNetworkInterface,
+) {
+	n.Opened = types.BoolValue(networkInterface.GetStatus() == networkInterfaceStatusOpen)
+	n.LinkSpeed = types.StringValue(networkInterface.GetLinkSpeed())
+	n.OperStatus = types.StringValue(networkInterface.GetOperStatus())
+	n.Status = types.StringValue(networkInterface.GetStatus())
+	n.SwitchInterface = types.StringValue(networkInterface.GetSwitchInterface())
+	n.SwitchName = types.StringValue(networkInterface.GetSwitchName())
+}
+
+func NewNetworkInterfaceResource() resource.Resource {
+	return &networkInterfaceResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_network_interface",
+		},
+	}
+}
+
+func (n *networkInterfaceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Opens or closes a network interface of a dedicated server. Destroying the resource leaves the network interface in its current state.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The network interface type. Valid options are \n  - *PUBLIC*\n  - *INTERNAL*\n  - *REMOTE_MANAGEMENT*\n",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(dedicatedserver.NETWORKTYPE_PUBLIC),
+						string(dedicatedserver.NETWORKTYPE_INTERNAL),
+						string(dedicatedserver.NETWORKTYPE_REMOTE_MANAGEMENT),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"opened": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the network interface is opened.",
+			},
+			"link_speed": schema.StringAttribute{
+				Computed:    true,
+				Description: "The link speed.",
+			},
+			"oper_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The operational status.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The administrative status.",
+			},
+			"switch_interface": schema.StringAttribute{
+				Computed:    true,
+				Description: "The switch port number.",
+			},
+			"switch_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The switch name.",
+			},
+		},
+	}
+}
+
+func (n *networkInterfaceResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkInterface, response, err := n.setNetworkInterfaceOpened(ctx, plan)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	plan.syncWithSDK(*networkInterface)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (n *networkInterfaceResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkInterface, response, err := n.DedicatedserverAPI.GetNetworkInterface(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+		networkTypeURLs[state.Type.ValueString()],
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state.syncWithSDK(*networkInterface)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (n *networkInterfaceResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkInterface, response, err := n.setNetworkInterfaceOpened(ctx, plan)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	plan.syncWithSDK(*networkInterface)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (n *networkInterfaceResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+func (n *networkInterfaceResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, ",")
+
+	// The type is used as URL path segment, so it must be a known type.
+	validType := false
+	if len(idParts) == 2 {
+		_, validType = networkTypeURLs[idParts[1]]
+	}
+
+	if !validType || idParts[0] == "" {
+		utils.UnexpectedImportIdentifierError(
+			&resp.Diagnostics,
+			"dedicated_server_id,type",
+			req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("dedicated_server_id"),
+		idParts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("type"),
+		idParts[1],
+	)...)
+}
+
+// setNetworkInterfaceOpened opens or closes the network interface and waits
+// until the switch reports the desired status.
+func (n *networkInterfaceResource) setNetworkInterfaceOpened(
+	ctx context.Context,
+	plan networkInterfaceResourceModel,
+) (*dedicatedserver.OperationNetworkInterface, *http.Response, error) {
+	serverID := plan.DedicatedServerID.ValueString()
+	networkTypeURL := networkTypeURLs[plan.Type.ValueString()]
+
+	expectedStatus := networkInterfaceStatusClosed
+	var response *http.Response
+	var err error
+	if plan.Opened.ValueBool() {
+		expectedStatus = networkInterfaceStatusOpen
+		response, err = n.DedicatedserverAPI.OpenNetworkInterface(ctx, serverID, networkTypeURL).Execute()
+	} else {
+		response, err = n.DedicatedserverAPI.CloseNetworkInterface(ctx, serverID, networkTypeURL).Execute()
+	}
+	if err != nil {
+		return nil, response, err
+	}
+
+	return n.waitUntilStatusEquals(ctx, serverID, networkTypeURL, expectedStatus)
+}
+
+func (n *networkInterfaceResource) waitUntilStatusEquals(
+	ctx context.Context,
+	serverID string,
+	networkTypeURL dedicatedserver.NetworkTypeURL,
+	expectedStatus string,
+) (*dedicatedserver.OperationNetworkInterface, *http.Response, error) {
+	// Poll every 10 seconds for 5 minutes
+	return waitUntil(
+		ctx,
+		10*time.Second,
+		30,
+		"network interface status to change",
+		func() (*dedicatedserver.OperationNetworkInterface, bool, *http.Response, error) {
+			networkInterface, response, err := n.DedicatedserverAPI.
+				GetNetworkInterface(ctx, serverID, networkTypeURL).
+				Execute()
+			if err != nil {
+				return nil, false, response, err
+			}
+
+			return networkInterface, networkInterface.GetStatus() == expectedStatus, response, nil
+		},
+	)
+}
@@ -2,8 +2,10 @@ package dedicatedserver
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,8 +17,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &notificationSettingBandwidthResource{}
-	_ resource.ResourceWithConfigure = &notificationSettingBandwidthResource{}
+	_ resource.Resource                = &notificationSettingBandwidthResource{}
+	_ resource.ResourceWithConfigure   = &notificationSettingBandwidthResource{}
+	_ resource.ResourceWithImportState = &notificationSettingBandwidthResource{}
 )
 
 type notificationSettingBandwidthResource struct {
@@ -220,3 +223,31 @@ func (n *notificationSettingBandwidthResource) Delete(
 		utils.SdkError(ctx, &resp.Diagnostics, err, response)
 	}
 }
+
+func (n *notificationSettingBandwidthResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		utils.UnexpectedImportIdentifierError(
+			&resp.Diagnostics,
+			"server_id,notification_setting_id",
+			req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("dedicated_server_id"),
+		idParts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("id"),
+		idParts[1],
+	)...)
+}
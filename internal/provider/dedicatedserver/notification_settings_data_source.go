@@ -0,0 +1,225 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &notificationSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &notificationSettingsDataSource{}
+)
+
+// sdkNotificationSetting is implemented by both the bandwidth and the
+// datatraffic notification settings of the SDK.
+type sdkNotificationSetting interface {
+	GetActions() []dedicatedserver.Actions
+	GetFrequency() string
+	GetId() string
+	GetLastCheckedAtOk() (*string, bool)
+	GetThreshold() string
+	GetThresholdExceededAtOk() (*string, bool)
+	GetUnit() string
+}
+
+type notificationSettingsDataSource struct {
+	utils.DataSourceAPI
+}
+
+type notificationSettingActionDataSourceModel struct {
+	LastTriggeredAt types.String `tfsdk:"last_triggered_at"`
+	Type            types.String `tfsdk:"type"`
+}
+
+type notificationSettingDataSourceModel struct {
+	ID                  types.String                               `tfsdk:"id"`
+	Actions             []notificationSettingActionDataSourceModel `tfsdk:"actions"`
+	Frequency           types.String                               `tfsdk:"frequency"`
+	LastCheckedAt       types.String                               `tfsdk:"last_checked_at"`
+	Threshold           types.String                               `tfsdk:"threshold"`
+	ThresholdExceededAt types.String                               `tfsdk:"threshold_exceeded_at"`
+	Unit                types.String                               `tfsdk:"unit"`
+}
+
+type notificationSettingsDataSourceModel struct {
+	DedicatedServerID types.String                         `tfsdk:"dedicated_server_id"`
+	Bandwidth         []notificationSettingDataSourceModel `tfsdk:"bandwidth"`
+	Datatraffic       []notificationSettingDataSourceModel `tfsdk:"datatraffic"`
+}
+
+func adaptNotificationSettingToNotificationSettingDataSource(
+	notificationSetting sdkNotificationSetting,
+) notificationSettingDataSourceModel {
+	actions := []notificationSettingActionDataSourceModel{}
+	for _, action := range notificationSetting.GetActions() {
+		lastTriggeredAt, _ := action.GetLastTriggeredAtOk()
+		actions = append(actions, notificationSettingActionDataSourceModel{
+			LastTriggeredAt: basetypes.NewStringPointerValue(lastTriggeredAt),
+			Type:            basetypes.NewStringValue(action.GetType()),
+		})
+	}
+
+	lastCheckedAt, _ := notificationSetting.GetLastCheckedAtOk()
+	thresholdExceededAt, _ := notificationSetting.GetThresholdExceededAtOk()
+
+	return notificationSettingDataSourceModel{
+		ID:                  basetypes.NewStringValue(notificationSetting.GetId()),
+		Actions:             actions,
+		Frequency:           basetypes.NewStringValue(notificationSetting.GetFrequency()),
+		LastCheckedAt:       basetypes.NewStringPointerValue(lastCheckedAt),
+		Threshold:           basetypes.NewStringValue(notificationSetting.GetThreshold()),
+		ThresholdExceededAt: basetypes.NewStringPointerValue(thresholdExceededAt),
+		Unit:                basetypes.NewStringValue(notificationSetting.GetUnit()),
+	}
+}
+
+func NewNotificationSettingsDataSource() datasource.DataSource {
+	return &notificationSettingsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_notification_settings",
+		},
+	}
+}
+
+func (n *notificationSettingsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	notificationSettingsAttribute := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Computed:    true,
+			Description: description,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The notification setting unique identifier.",
+					},
+					"actions": schema.ListNestedAttribute{
+						Computed:    true,
+						Description: "The actions taken when the threshold is exceeded.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"last_triggered_at": schema.StringAttribute{
+									Computed:    true,
+									Description: "Date timestamp when the action was last triggered.",
+								},
+								"type": schema.StringAttribute{
+									Computed:    true,
+									Description: "The type of the action, i.e. *EMAIL*.",
+								},
+							},
+						},
+					},
+					"frequency": schema.StringAttribute{
+						Computed:    true,
+						Description: "The notification frequency.",
+					},
+					"last_checked_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "Date timestamp when the system last checked the server for threshold limit.",
+					},
+					"threshold": schema.StringAttribute{
+						Computed:    true,
+						Description: "Threshold value.",
+					},
+					"threshold_exceeded_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "Date timestamp when the server exceeded the threshold limit.",
+					},
+					"unit": schema.StringAttribute{
+						Computed:    true,
+						Description: "The notification unit.",
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the bandwidth and datatraffic notification settings of a dedicated server.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+			},
+			"bandwidth":   notificationSettingsAttribute("The bandwidth notification settings."),
+			"datatraffic": notificationSettingsAttribute("The datatraffic notification settings."),
+		},
+	}
+}
+
+func (n *notificationSettingsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config notificationSettingsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.DedicatedServerID.ValueString()
+	state := notificationSettingsDataSourceModel{
+		DedicatedServerID: config.DedicatedServerID,
+		Bandwidth:         []notificationSettingDataSourceModel{},
+		Datatraffic:       []notificationSettingDataSourceModel{},
+	}
+
+	bandwidthRequest := n.DedicatedserverAPI.GetBandwidthNotificationSettingList(ctx, serverID)
+	bandwidthNotificationSettings, response, err := utils.GetAllPages(
+		func(offset int32) (*utils.Page[dedicatedserver.BandwidthNotificationSetting], *http.Response, error) {
+			result, response, err := bandwidthRequest.Limit(pageLimit).Offset(offset).Execute()
+			if err != nil {
+				return nil, response, err
+			}
+
+			return newPage(result.GetBandwidthNotificationSettings(), result.GetMetadata()), nil, nil
+		},
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	for _, notificationSetting := range bandwidthNotificationSettings {
+		state.Bandwidth = append(
+			state.Bandwidth,
+			adaptNotificationSettingToNotificationSettingDataSource(&notificationSetting),
+		)
+	}
+
+	datatrafficRequest := n.DedicatedserverAPI.GetDataTrafficNotificationSettingList(ctx, serverID)
+	datatrafficNotificationSettings, response, err := utils.GetAllPages(
+		func(offset int32) (*utils.Page[dedicatedserver.DataTrafficNotificationSetting], *http.Response, error) {
+			result, response, err := datatrafficRequest.Limit(pageLimit).Offset(offset).Execute()
+			if err != nil {
+				return nil, response, err
+			}
+
+			return newPage(result.GetDatatrafficNotificationSettings(), result.GetMetadata()), nil, nil
+		},
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	for _, notificationSetting := range datatrafficNotificationSettings {
+		state.Datatraffic = append(
+			state.Datatraffic,
+			adaptNotificationSettingToNotificationSettingDataSource(&notificationSetting),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
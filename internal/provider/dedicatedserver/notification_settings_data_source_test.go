@@ -0,0 +1,35 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptNotificationSettingToNotificationSettingDataSource(t *testing.T) {
+	notificationSetting := dedicatedserver.NewBandwidthNotificationSetting(
+		"WEEKLY",
+		"12345",
+		"1",
+		"Gbps",
+	)
+	notificationSetting.SetThresholdExceededAt("2021-03-16T01:01:41+00:00")
+	action := dedicatedserver.NewActions()
+	action.SetType("EMAIL")
+	action.SetLastTriggeredAt("2021-03-16T01:01:44+00:00")
+	notificationSetting.SetActions([]dedicatedserver.Actions{*action})
+
+	got := adaptNotificationSettingToNotificationSettingDataSource(notificationSetting)
+
+	assert.Equal(t, "12345", got.ID.ValueString())
+	assert.Equal(t, "WEEKLY", got.Frequency.ValueString())
+	assert.Equal(t, "1", got.Threshold.ValueString())
+	assert.Equal(t, "Gbps", got.Unit.ValueString())
+	assert.True(t, got.LastCheckedAt.IsNull())
+	assert.Equal(t, "2021-03-16T01:01:41+00:00", got.ThresholdExceededAt.ValueString())
+	require.Len(t, got.Actions, 1)
+	assert.Equal(t, "EMAIL", got.Actions[0].Type.ValueString())
+	assert.Equal(t, "2021-03-16T01:01:44+00:00", got.Actions[0].LastTriggeredAt.ValueString())
+}
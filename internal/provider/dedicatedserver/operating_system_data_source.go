@@ -0,0 +1,240 @@
+package dedicatedserver
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &operatingSystemDataSource{}
+	_ datasource.DataSourceWithConfigure = &operatingSystemDataSource{}
+)
+
+// operatingSystemFeatureControlPanel is listed in the features of operating
+// systems that support a control panel.
+const operatingSystemFeatureControlPanel = "CONTROL_PANEL"
+
+type operatingSystemDataSource struct {
+	utils.DataSourceAPI
+}
+
+type operatingSystemPartitionDataSourceModel struct {
+	Filesystem types.String `tfsdk:"filesystem"`
+	Mountpoint types.String `tfsdk:"mountpoint"`
+	Size       types.String `tfsdk:"size"`
+}
+
+type operatingSystemDefaultsDataSourceModel struct {
+	Device     types.String                              `tfsdk:"device"`
+	Partitions []operatingSystemPartitionDataSourceModel `tfsdk:"partitions"`
+}
+
+type operatingSystemDetailsDataSourceModel struct {
+	OperatingSystemID     types.String                            `tfsdk:"operating_system_id"`
+	ControlPanelID        types.String                            `tfsdk:"control_panel_id"`
+	Architecture          types.String                            `tfsdk:"architecture"`
+	Configurable          types.Bool                              `tfsdk:"configurable"`
+	ControlPanelSupported types.Bool                              `tfsdk:"control_panel_supported"`
+	Defaults              *operatingSystemDefaultsDataSourceModel `tfsdk:"defaults"`
+	Family                types.String                            `tfsdk:"family"`
+	Features              []types.String                          `tfsdk:"features"`
+	Name                  types.String                            `tfsdk:"name"`
+	SupportedBootDevices  []types.String                          `tfsdk:"supported_boot_devices"`
+	SupportedFileSystems  []types.String                          `tfsdk:"supported_file_systems"`
+	Type                  types.String                            `tfsdk:"type"`
+	Version               types.String                            `tfsdk:"version"`
+}
+
+func adaptStringsToStringValues(values []string) []types.String {
+	stringValues := []types.String{}
+	for _, value := range values {
+		stringValues = append(stringValues, basetypes.NewStringValue(value))
+	}
+
+	return stringValues
+}
+
+func adaptOperatingSystemToOperatingSystemDataSource(
+	operatingSystem dedicatedserver.GetOperatingSystemResult,
+	config operatingSystemDetailsDataSourceModel,
+) operatingSystemDetailsDataSourceModel {
+	var defaults *operatingSystemDefaultsDataSourceModel
+	if sdkDefaults, ok := operatingSystem.GetDefaultsOk(); ok {
+		partitions := []operatingSystemPartitionDataSourceModel{}
+		for _, partition := range sdkDefaults.GetPartitions() {
+			filesystem, _ := partition.GetFilesystemOk()
+			mountpoint, _ := partition.GetMountpointOk()
+			size, _ := partition.GetSizeOk()
+			partitions = append(partitions, operatingSystemPartitionDataSourceModel{
+				Filesystem: basetypes.NewStringPointerValue(filesystem),
+				Mountpoint: basetypes.NewStringPointerValue(mountpoint),
+				Size:       basetypes.NewStringPointerValue(size),
+			})
+		}
+
+		device, _ := sdkDefaults.GetDeviceOk()
+		defaults = &operatingSystemDefaultsDataSourceModel{
+			Device:     basetypes.NewStringPointerValue(device),
+			Partitions: partitions,
+		}
+	}
+
+	return operatingSystemDetailsDataSourceModel{
+		OperatingSystemID: config.OperatingSystemID,
+		ControlPanelID:    config.ControlPanelID,
+		Architecture:      basetypes.NewStringValue(operatingSystem.GetArchitecture()),
+		Configurable:      basetypes.NewBoolValue(operatingSystem.GetConfigurable()),
+		ControlPanelSupported: basetypes.NewBoolValue(
+			slices.Contains(operatingSystem.GetFeatures(), operatingSystemFeatureControlPanel),
+		),
+		Defaults:             defaults,
+		Family:               basetypes.NewStringValue(operatingSystem.GetFamily()),
+		Features:             adaptStringsToStringValues(operatingSystem.GetFeatures()),
+		Name:                 basetypes.NewStringValue(operatingSystem.GetName()),
+		SupportedBootDevices: adaptStringsToStringValues(operatingSystem.GetSupportedBootDevices()),
+		SupportedFileSystems: adaptStringsToStringValues(operatingSystem.GetSupportedFileSystems()),
+		Type:                 basetypes.NewStringValue(operatingSystem.GetType()),
+		Version:              basetypes.NewStringValue(operatingSystem.GetVersion()),
+	}
+}
+
+func NewOperatingSystemDataSource() datasource.DataSource {
+	return &operatingSystemDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_operating_system",
+		},
+	}
+}
+
+func (o *operatingSystemDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the details and installation defaults of an operating system for dedicated servers.",
+		Attributes: map[string]schema.Attribute{
+			"operating_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the operating system.",
+			},
+			"control_panel_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the control panel. The defaults can differ when a control panel is installed.",
+			},
+			"architecture": schema.StringAttribute{
+				Computed:    true,
+				Description: "The architecture of the operating system.",
+			},
+			"configurable": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the default options are configurable or not.",
+			},
+			"control_panel_supported": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a control panel can be installed with the operating system.",
+			},
+			"defaults": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The installation defaults of the operating system.",
+				Attributes: map[string]schema.Attribute{
+					"device": schema.StringAttribute{
+						Computed:    true,
+						Description: "The default boot device.",
+					},
+					"partitions": schema.ListNestedAttribute{
+						Computed:    true,
+						Description: "The default partitions. They can be passed to the `partitions` of `leaseweb_dedicated_server_installation`.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"filesystem": schema.StringAttribute{
+									Computed:    true,
+									Description: "The file system of the partition.",
+								},
+								"mountpoint": schema.StringAttribute{
+									Computed:    true,
+									Description: "The mount point of the partition. Empty for swap partitions.",
+								},
+								"size": schema.StringAttribute{
+									Computed:    true,
+									Description: "The size of the partition in MB, `*` means the remaining space.",
+								},
+							},
+						},
+					},
+				},
+			},
+			"family": schema.StringAttribute{
+				Computed:    true,
+				Description: "The operating system family.",
+			},
+			"features": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The features of the operating system.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "A human readable name for the operating system.",
+			},
+			"supported_boot_devices": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The boot devices supported by the operating system.",
+			},
+			"supported_file_systems": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The file systems supported by the operating system.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of operating system.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the operating system.",
+			},
+		},
+	}
+}
+
+func (o *operatingSystemDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config operatingSystemDetailsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := o.DedicatedserverAPI.GetOperatingSystem(
+		ctx,
+		config.OperatingSystemID.ValueString(),
+	)
+	if !config.ControlPanelID.IsNull() && !config.ControlPanelID.IsUnknown() {
+		request = request.ControlPanelId(config.ControlPanelID.ValueString())
+	}
+
+	result, response, err := request.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(
+			ctx,
+			adaptOperatingSystemToOperatingSystemDataSource(*result, config),
+		)...,
+	)
+}
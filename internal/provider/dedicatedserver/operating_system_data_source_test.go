@@ -0,0 +1,83 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptOperatingSystemToOperatingSystemDataSource(t *testing.T) {
+	config := operatingSystemDetailsDataSourceModel{
+		OperatingSystemID: basetypes.NewStringValue("CENTOS_7_64BIT"),
+		ControlPanelID:    basetypes.NewStringNull(),
+	}
+
+	t.Run("adapts the operating system and its defaults", func(t *testing.T) {
+		got := adaptOperatingSystemToOperatingSystemDataSource(
+			dedicatedserver.GetOperatingSystemResult{
+				Architecture: dedicatedserver.PtrString("64bit"),
+				Configurable: dedicatedserver.PtrBool(true),
+				Defaults: &dedicatedserver.Defaults{
+					Device: dedicatedserver.PtrString("SATA_SAS"),
+					Partitions: []dedicatedserver.Partition{
+						{
+							Filesystem: dedicatedserver.PtrString("swap"),
+							Size:       dedicatedserver.PtrString("4096"),
+						},
+						{
+							Filesystem: dedicatedserver.PtrString("ext4"),
+							Mountpoint: dedicatedserver.PtrString("/"),
+							Size:       dedicatedserver.PtrString("*"),
+						},
+					},
+				},
+				Family:               dedicatedserver.PtrString("centos"),
+				Features:             []string{"PARTITIONING", "CONTROL_PANEL"},
+				Id:                   dedicatedserver.PtrString("CENTOS_7_64BIT"),
+				Name:                 dedicatedserver.PtrString("CentOS 7 (x86_64)"),
+				SupportedBootDevices: []string{"SATA_SAS", "NVME"},
+				SupportedFileSystems: []string{"ext4", "swap"},
+				Type:                 dedicatedserver.PtrString("linux"),
+				Version:              dedicatedserver.PtrString("7"),
+			},
+			config,
+		)
+
+		assert.Equal(t, "CENTOS_7_64BIT", got.OperatingSystemID.ValueString())
+		assert.True(t, got.ControlPanelID.IsNull())
+		assert.Equal(t, "64bit", got.Architecture.ValueString())
+		assert.True(t, got.Configurable.ValueBool())
+		assert.True(t, got.ControlPanelSupported.ValueBool())
+		assert.Equal(t, "centos", got.Family.ValueString())
+		assert.Len(t, got.Features, 2)
+		assert.Equal(t, "CentOS 7 (x86_64)", got.Name.ValueString())
+		assert.Equal(t, "NVME", got.SupportedBootDevices[1].ValueString())
+		assert.Equal(t, "swap", got.SupportedFileSystems[1].ValueString())
+		assert.Equal(t, "linux", got.Type.ValueString())
+		assert.Equal(t, "7", got.Version.ValueString())
+
+		require.NotNil(t, got.Defaults)
+		assert.Equal(t, "SATA_SAS", got.Defaults.Device.ValueString())
+		require.Len(t, got.Defaults.Partitions, 2)
+		assert.True(t, got.Defaults.Partitions[0].Mountpoint.IsNull())
+		assert.Equal(t, "swap", got.Defaults.Partitions[0].Filesystem.ValueString())
+		assert.Equal(t, "/", got.Defaults.Partitions[1].Mountpoint.ValueString())
+		assert.Equal(t, "*", got.Defaults.Partitions[1].Size.ValueString())
+	})
+
+	t.Run("control panel is not supported without the feature", func(t *testing.T) {
+		got := adaptOperatingSystemToOperatingSystemDataSource(
+			dedicatedserver.GetOperatingSystemResult{
+				Features: []string{"PARTITIONING"},
+			},
+			config,
+		)
+
+		assert.False(t, got.ControlPanelSupported.ValueBool())
+		assert.Nil(t, got.Defaults)
+		assert.Empty(t, got.SupportedFileSystems)
+	})
+}
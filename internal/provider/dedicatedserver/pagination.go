@@ -0,0 +1,21 @@
+package dedicatedserver
+
+import (
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+// pageLimit is the number of items retrieved per request when paging through
+// a list.
+const pageLimit int32 = 50
+
+// newPage wraps the items of a list result with its metadata, so that the
+// list can be retrieved with utils.GetAllPages.
+func newPage[T any](items []T, metadata dedicatedserver.Metadata) *utils.Page[T] {
+	return &utils.Page[T]{
+		Items:      items,
+		Limit:      metadata.GetLimit(),
+		Offset:     metadata.GetOffset(),
+		TotalCount: metadata.GetTotalCount(),
+	}
+}
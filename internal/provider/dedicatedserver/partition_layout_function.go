@@ -0,0 +1,270 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &partitionLayoutFunction{}
+)
+
+const (
+	swapPartitionKey       = "swap"
+	rootPartitionKey       = "/"
+	bootPartitionKey       = "/boot"
+	remainingPartitionSize = "*"
+)
+
+var partitionSizeRegexp = regexp.MustCompile(`(?i)^(\d+)\s*(M|MB|G|GB|T|TB)?$`)
+
+type partitionLayoutFunction struct{}
+
+func NewPartitionLayoutFunction() function.Function {
+	return &partitionLayoutFunction{}
+}
+
+func (p *partitionLayoutFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "partition_layout"
+}
+
+func (p *partitionLayoutFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the partitions of a dedicated server installation",
+		Description: "Converts a compact partition specification into the list of partitions expected by the `partitions` attribute of `leaseweb_dedicated_server_installation`. Partitions are returned in installation order: `/boot` first, followed by `swap`, the fixed size partitions and finally the partition that takes the remaining space.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "spec",
+				ElementType: types.StringType,
+				Description: "Map of mount points (or `swap`) to sizes. Sizes are expressed in MB, or with a `M`, `G` or `T` suffix. Exactly one partition may use `*` to take the remaining space. The default filesystem can be overridden by prefixing the size with the filesystem, i.e.: `xfs:20G`.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: partitionsResourceModel{}.attributeTypes(),
+			},
+		},
+	}
+}
+
+func (p *partitionLayoutFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var spec map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &spec))
+	if resp.Error != nil {
+		return
+	}
+
+	partitions, err := buildPartitionLayout(spec)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	partitionList, diags := types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: partitionsResourceModel{}.attributeTypes()},
+		partitions,
+	)
+	resp.Error = function.ConcatFuncErrors(
+		resp.Error,
+		function.FuncErrorFromDiags(ctx, diags),
+	)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(
+		resp.Error,
+		resp.Result.Set(ctx, partitionList),
+	)
+}
+
+// buildPartitionLayout validates the partition specification and converts it
+// into partitions sorted in installation order.
+func buildPartitionLayout(spec map[string]string) ([]partitionsResourceModel, error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("the partition specification cannot be empty")
+	}
+
+	if _, ok := spec[rootPartitionKey]; !ok {
+		return nil, fmt.Errorf("the partition specification must contain a root (%q) partition", rootPartitionKey)
+	}
+
+	var remainingKeys []string
+	keys := make([]string, 0, len(spec))
+	for key := range spec {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	partitions := make(map[string]partitionsResourceModel, len(spec))
+	for _, key := range keys {
+		if key != swapPartitionKey && !strings.HasPrefix(key, "/") {
+			return nil, fmt.Errorf(
+				"invalid partition %q: the key must be %q or an absolute mount point",
+				key,
+				swapPartitionKey,
+			)
+		}
+
+		filesystem, size, err := parsePartitionValue(key, spec[key])
+		if err != nil {
+			return nil, err
+		}
+
+		if size == remainingPartitionSize {
+			if key == swapPartitionKey {
+				return nil, fmt.Errorf("the %q partition cannot use the remaining space", swapPartitionKey)
+			}
+			remainingKeys = append(remainingKeys, key)
+		}
+
+		partition := partitionsResourceModel{
+			Filesystem: types.StringValue(filesystem),
+			Mountpoint: types.StringValue(key),
+			Size:       types.StringValue(size),
+		}
+		if key == swapPartitionKey {
+			partition.Mountpoint = types.StringNull()
+		}
+		partitions[key] = partition
+	}
+
+	if len(remainingKeys) > 1 {
+		return nil, fmt.Errorf(
+			"only one partition can use %q as size, got: %s",
+			remainingPartitionSize,
+			strings.Join(remainingKeys, ", "),
+		)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return partitionOrder(partitions[keys[i]]) < partitionOrder(partitions[keys[j]])
+	})
+
+	layout := make([]partitionsResourceModel, 0, len(keys))
+	for _, key := range keys {
+		layout = append(layout, partitions[key])
+	}
+
+	return layout, nil
+}
+
+// parsePartitionValue splits an optional filesystem prefix from the size and
+// normalizes the size to MB.
+func parsePartitionValue(key string, value string) (string, string, error) {
+	filesystem := defaultPartitionFilesystem(key)
+	size := strings.TrimSpace(value)
+
+	if prefix, rest, found := strings.Cut(size, ":"); found {
+		filesystem = strings.TrimSpace(prefix)
+		size = strings.TrimSpace(rest)
+		if filesystem == "" {
+			return "", "", fmt.Errorf("invalid partition %q: the filesystem cannot be empty", key)
+		}
+	}
+
+	if key == swapPartitionKey && filesystem != swapPartitionKey {
+		return "", "", fmt.Errorf("invalid partition %q: the filesystem must be %q", key, swapPartitionKey)
+	}
+	if key != swapPartitionKey && filesystem == swapPartitionKey {
+		return "", "", fmt.Errorf(
+			"invalid partition %q: only the %q partition can use the %q filesystem",
+			key,
+			swapPartitionKey,
+			swapPartitionKey,
+		)
+	}
+
+	if size == remainingPartitionSize {
+		return filesystem, size, nil
+	}
+
+	normalizedSize, err := normalizePartitionSize(size)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid partition %q: %w", key, err)
+	}
+
+	return filesystem, normalizedSize, nil
+}
+
+// normalizePartitionSize converts a size with an optional unit suffix to MB.
+func normalizePartitionSize(size string) (string, error) {
+	matches := partitionSizeRegexp.FindStringSubmatch(size)
+	if matches == nil {
+		return "", fmt.Errorf(
+			"size %q must be a number optionally followed by M, G or T, or %q",
+			size,
+			remainingPartitionSize,
+		)
+	}
+
+	value, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("size %q is out of range", size)
+	}
+	if value <= 0 {
+		return "", fmt.Errorf("size %q must be greater than 0", size)
+	}
+
+	switch strings.TrimSuffix(strings.ToUpper(matches[2]), "B") {
+	case "G":
+		value *= 1024
+	case "T":
+		value *= 1024 * 1024
+	}
+
+	return strconv.FormatInt(value, 10), nil
+}
+
+func defaultPartitionFilesystem(key string) string {
+	switch key {
+	case swapPartitionKey:
+		return "swap"
+	case bootPartitionKey:
+		return "ext2"
+	default:
+		return "ext4"
+	}
+}
+
+// partitionOrder determines where a partition goes in the installation
+// order. The partition that takes the remaining space must always be last.
+func partitionOrder(partition partitionsResourceModel) int {
+	if partition.Size.ValueString() == remainingPartitionSize {
+		return 4
+	}
+
+	if partition.Filesystem.ValueString() == swapPartitionKey {
+		return 1
+	}
+
+	switch partition.Mountpoint.ValueString() {
+	case bootPartitionKey:
+		return 0
+	case rootPartitionKey:
+		return 2
+	default:
+		return 3
+	}
+}
@@ -0,0 +1,141 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildPartitionLayout(t *testing.T) {
+	t.Run("returns partitions in installation order", func(t *testing.T) {
+		got, err := buildPartitionLayout(map[string]string{
+			"/":     "*",
+			"/tmp":  "4G",
+			"/boot": "1G",
+			"swap":  "4096",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			[]partitionsResourceModel{
+				{
+					Filesystem: types.StringValue("ext2"),
+					Mountpoint: types.StringValue("/boot"),
+					Size:       types.StringValue("1024"),
+				},
+				{
+					Filesystem: types.StringValue("swap"),
+					Mountpoint: types.StringNull(),
+					Size:       types.StringValue("4096"),
+				},
+				{
+					Filesystem: types.StringValue("ext4"),
+					Mountpoint: types.StringValue("/tmp"),
+					Size:       types.StringValue("4096"),
+				},
+				{
+					Filesystem: types.StringValue("ext4"),
+					Mountpoint: types.StringValue("/"),
+					Size:       types.StringValue("*"),
+				},
+			},
+			got,
+		)
+	})
+
+	t.Run("root partition goes before the remaining space partition", func(t *testing.T) {
+		got, err := buildPartitionLayout(map[string]string{
+			"/home": "*",
+			"/var":  "10G",
+			"/":     "20G",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, "/", got[0].Mountpoint.ValueString())
+		assert.Equal(t, "/var", got[1].Mountpoint.ValueString())
+		assert.Equal(t, "/home", got[2].Mountpoint.ValueString())
+	})
+
+	t.Run("filesystem can be overridden", func(t *testing.T) {
+		got, err := buildPartitionLayout(map[string]string{
+			"/": "xfs:1T",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "xfs", got[0].Filesystem.ValueString())
+		assert.Equal(t, "1048576", got[0].Size.ValueString())
+	})
+
+	t.Run("returns an error if the root partition is missing", func(t *testing.T) {
+		_, err := buildPartitionLayout(map[string]string{
+			"/boot": "1G",
+			"swap":  "4G",
+		})
+
+		assert.ErrorContains(t, err, "must contain a root")
+	})
+
+	t.Run("returns an error if the specification is empty", func(t *testing.T) {
+		_, err := buildPartitionLayout(map[string]string{})
+
+		assert.ErrorContains(t, err, "cannot be empty")
+	})
+
+	t.Run("returns an error if more than one partition uses the remaining space", func(t *testing.T) {
+		_, err := buildPartitionLayout(map[string]string{
+			"/":     "*",
+			"/home": "*",
+		})
+
+		assert.ErrorContains(t, err, "only one partition")
+	})
+
+	t.Run("returns an error if swap uses the remaining space", func(t *testing.T) {
+		_, err := buildPartitionLayout(map[string]string{
+			"/":    "20G",
+			"swap": "*",
+		})
+
+		assert.ErrorContains(t, err, "cannot use the remaining space")
+	})
+
+	t.Run("returns an error if a second partition uses the swap filesystem", func(t *testing.T) {
+		_, err := buildPartitionLayout(map[string]string{
+			"/":     "*",
+			"swap":  "4G",
+			"/swap": "swap:4G",
+		})
+
+		assert.ErrorContains(t, err, "only the \"swap\" partition")
+	})
+
+	t.Run("returns an error if the mount point is not absolute", func(t *testing.T) {
+		_, err := buildPartitionLayout(map[string]string{
+			"/":    "*",
+			"home": "4G",
+		})
+
+		assert.ErrorContains(t, err, "absolute mount point")
+	})
+
+	t.Run("returns an error if the size is invalid", func(t *testing.T) {
+		_, err := buildPartitionLayout(map[string]string{
+			"/": "lots",
+		})
+
+		assert.ErrorContains(t, err, "must be a number")
+	})
+
+	t.Run("returns an error if the size is zero", func(t *testing.T) {
+		_, err := buildPartitionLayout(map[string]string{
+			"/": "0G",
+		})
+
+		assert.ErrorContains(t, err, "greater than 0")
+	})
+}
@@ -0,0 +1,102 @@
+package dedicatedserver
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	passwordLowerCharacters   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpperCharacters   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumericCharacters = "0123456789"
+	passwordSpecialCharacters = "!#%()*+,-.:=?@[]_{}~"
+
+	defaultPasswordLength int32 = 32
+)
+
+type passwordPolicyResourceModel struct {
+	Length  types.Int32 `tfsdk:"length"`
+	Lower   types.Bool  `tfsdk:"lower"`
+	Upper   types.Bool  `tfsdk:"upper"`
+	Numeric types.Bool  `tfsdk:"numeric"`
+	Special types.Bool  `tfsdk:"special"`
+}
+
+func defaultPasswordPolicy() passwordPolicyResourceModel {
+	return passwordPolicyResourceModel{
+		Length:  types.Int32Value(defaultPasswordLength),
+		Lower:   types.BoolValue(true),
+		Upper:   types.BoolValue(true),
+		Numeric: types.BoolValue(true),
+		Special: types.BoolValue(true),
+	}
+}
+
+// generatePassword returns a random password of the policy's length that
+// contains at least one character of every enabled character class.
+func generatePassword(policy passwordPolicyResourceModel) (string, error) {
+	var classes []string
+	if policy.Lower.ValueBool() {
+		classes = append(classes, passwordLowerCharacters)
+	}
+	if policy.Upper.ValueBool() {
+		classes = append(classes, passwordUpperCharacters)
+	}
+	if policy.Numeric.ValueBool() {
+		classes = append(classes, passwordNumericCharacters)
+	}
+	if policy.Special.ValueBool() {
+		classes = append(classes, passwordSpecialCharacters)
+	}
+
+	if len(classes) == 0 {
+		return "", errors.New("the password policy must enable at least one character class")
+	}
+
+	length := int(policy.Length.ValueInt32())
+	if length < len(classes) {
+		return "", errors.New("the password length must be at least the number of enabled character classes")
+	}
+
+	password := make([]byte, 0, length)
+	var allCharacters string
+	for _, class := range classes {
+		character, err := randomCharacter(class)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, character)
+		allCharacters += class
+	}
+
+	for len(password) < length {
+		character, err := randomCharacter(allCharacters)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, character)
+	}
+
+	// Shuffle so the required characters are not always at the start.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+func randomCharacter(characters string) (byte, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+	if err != nil {
+		return 0, err
+	}
+
+	return characters[index.Int64()], nil
+}
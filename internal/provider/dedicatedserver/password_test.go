@@ -0,0 +1,58 @@
+package dedicatedserver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_generatePassword(t *testing.T) {
+	t.Run("password contains every enabled character class", func(t *testing.T) {
+		got, err := generatePassword(defaultPasswordPolicy())
+
+		require.NoError(t, err)
+		assert.Len(t, got, int(defaultPasswordLength))
+		assert.True(t, strings.ContainsAny(got, passwordLowerCharacters))
+		assert.True(t, strings.ContainsAny(got, passwordUpperCharacters))
+		assert.True(t, strings.ContainsAny(got, passwordNumericCharacters))
+		assert.True(t, strings.ContainsAny(got, passwordSpecialCharacters))
+	})
+
+	t.Run("password does not contain disabled character classes", func(t *testing.T) {
+		policy := defaultPasswordPolicy()
+		policy.Length = types.Int32Value(64)
+		policy.Special = types.BoolValue(false)
+		policy.Upper = types.BoolValue(false)
+
+		got, err := generatePassword(policy)
+
+		require.NoError(t, err)
+		assert.Len(t, got, 64)
+		assert.False(t, strings.ContainsAny(got, passwordSpecialCharacters))
+		assert.False(t, strings.ContainsAny(got, passwordUpperCharacters))
+	})
+
+	t.Run("passwords are random", func(t *testing.T) {
+		first, err := generatePassword(defaultPasswordPolicy())
+		require.NoError(t, err)
+		second, err := generatePassword(defaultPasswordPolicy())
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("at least one character class must be enabled", func(t *testing.T) {
+		_, err := generatePassword(passwordPolicyResourceModel{
+			Length:  types.Int32Value(16),
+			Lower:   types.BoolValue(false),
+			Upper:   types.BoolValue(false),
+			Numeric: types.BoolValue(false),
+			Special: types.BoolValue(false),
+		})
+
+		assert.ErrorContains(t, err, "at least one character class")
+	})
+}
@@ -0,0 +1,315 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &privateNetworkResource{}
+	_ resource.ResourceWithImportState = &privateNetworkResource{}
+)
+
+const privateNetworkStatusConfigured = "CONFIGURED"
+
+type privateNetworkResource struct {
+	utils.ResourceAPI
+}
+
+type privateNetworkResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	PrivateNetworkID  types.String `tfsdk:"private_network_id"`
+	LinkSpeed         types.Int32  `tfsdk:"link_speed"`
+	DHCP              types.String `tfsdk:"dhcp"`
+	Status            types.String `tfsdk:"status"`
+	Subnet            types.String `tfsdk:"subnet"`
+	VlanID            types.String `tfsdk:"vlan_id"`
+}
+
+func (p *privateNetworkResourceModel) syncWithSDK(
+	privateNetwork dedicatedserver.PrivateNetwork,
+) {
+	p.LinkSpeed = types.Int32Value(int32(privateNetwork.GetLinkSpeed()))
+	p.DHCP = types.StringValue(privateNetwork.GetDhcp())
+	p.Status = types.StringValue(privateNetwork.GetStatus())
+	p.Subnet = types.StringValue(privateNetwork.GetSubnet())
+	p.VlanID = types.StringValue(privateNetwork.GetVlanId())
+}
+
+// findPrivateNetwork returns the private network the server is a member of,
+// or nil if the server is not a member.
+func findPrivateNetwork(
+	server dedicatedserver.Server,
+	privateNetworkID string,
+) *dedicatedserver.PrivateNetwork {
+	for _, privateNetwork := range server.GetPrivateNetworks() {
+		if privateNetwork.GetId() == privateNetworkID {
+			return &privateNetwork
+		}
+	}
+
+	return nil
+}
+
+func NewPrivateNetworkResource() resource.Resource {
+	return &privateNetworkResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_private_network",
+		},
+	}
+}
+
+func (p *privateNetworkResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	linkSpeeds := utils.NewIntMarkdownList(dedicatedserver.AllowedLinkSpeedEnumValues)
+
+	resp.Schema = schema.Schema{
+		Description: "Adds a dedicated server to a private network. It takes a few minutes before the server has access to the private network.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"link_speed": schema.Int32Attribute{
+				Required:    true,
+				Description: "The port speed in Mbps. Valid options are " + linkSpeeds.Markdown(),
+				Validators: []validator.Int32{
+					int32validator.OneOf(linkSpeeds.ToInt32()...),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"dhcp": schema.StringAttribute{
+				Computed:    true,
+				Description: "Whether DHCP is enabled on the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the server in the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subnet of the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vlan_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The VLAN ID of the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (p *privateNetworkResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan privateNetworkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.DedicatedServerID.ValueString()
+	privateNetworkID := plan.PrivateNetworkID.ValueString()
+
+	opts := dedicatedserver.NewAddToPrivateNetworkOpts(
+		dedicatedserver.LinkSpeed(plan.LinkSpeed.ValueInt32()),
+	)
+	response, err := p.DedicatedserverAPI.AddToPrivateNetwork(ctx, serverID, privateNetworkID).
+		AddToPrivateNetworkOpts(*opts).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	privateNetwork, response, err := p.waitUntilPrivateNetwork(
+		ctx,
+		serverID,
+		privateNetworkID,
+		"become "+privateNetworkStatusConfigured,
+		func(privateNetwork *dedicatedserver.PrivateNetwork) bool {
+			return privateNetwork != nil && privateNetwork.GetStatus() == privateNetworkStatusConfigured
+		},
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	plan.syncWithSDK(*privateNetwork)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (p *privateNetworkResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state privateNetworkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, response, err := p.DedicatedserverAPI.GetServer(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	// The server has been removed from the private network outside of Terraform.
+	privateNetwork := findPrivateNetwork(*server, state.PrivateNetworkID.ValueString())
+	if privateNetwork == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.syncWithSDK(*privateNetwork)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (p *privateNetworkResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+func (p *privateNetworkResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state privateNetworkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.DedicatedServerID.ValueString()
+	privateNetworkID := state.PrivateNetworkID.ValueString()
+
+	response, err := p.DedicatedserverAPI.DeleteFromPrivateNetwork(
+		ctx,
+		serverID,
+		privateNetworkID,
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	_, response, err = p.waitUntilPrivateNetwork(
+		ctx,
+		serverID,
+		privateNetworkID,
+		"be removed",
+		func(privateNetwork *dedicatedserver.PrivateNetwork) bool {
+			return privateNetwork == nil
+		},
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+	}
+}
+
+func (p *privateNetworkResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		utils.UnexpectedImportIdentifierError(
+			&resp.Diagnostics,
+			"dedicated_server_id,private_network_id",
+			req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("dedicated_server_id"),
+		idParts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("private_network_id"),
+		idParts[1],
+	)...)
+}
+
+// waitUntilPrivateNetwork polls the server until the membership of the
+// private network satisfies done.
+func (p *privateNetworkResource) waitUntilPrivateNetwork(
+	ctx context.Context,
+	serverID string,
+	privateNetworkID string,
+	description string,
+	done func(privateNetwork *dedicatedserver.PrivateNetwork) bool,
+) (*dedicatedserver.PrivateNetwork, *http.Response, error) {
+	// Poll every 10 seconds for 10 minutes
+	return waitUntil(
+		ctx,
+		10*time.Second,
+		60,
+		fmt.Sprintf("private network %s of server %s to %s", privateNetworkID, serverID, description),
+		func() (*dedicatedserver.PrivateNetwork, bool, *http.Response, error) {
+			server, response, err := p.DedicatedserverAPI.GetServer(ctx, serverID).Execute()
+			if err != nil {
+				return nil, false, response, err
+			}
+
+			privateNetwork := findPrivateNetwork(*server, privateNetworkID)
+			return privateNetwork, done(privateNetwork), response, nil
+		},
+	)
+}
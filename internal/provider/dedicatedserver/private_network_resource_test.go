@@ -0,0 +1,55 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findPrivateNetwork(t *testing.T) {
+	server := dedicatedserver.Server{
+		PrivateNetworks: []dedicatedserver.PrivateNetwork{
+			{
+				Id:     dedicatedserver.PtrString("1238793"),
+				Status: dedicatedserver.PtrString("CONFIGURED"),
+			},
+			{
+				Id:     dedicatedserver.PtrString("7654321"),
+				Status: dedicatedserver.PtrString("CONFIGURING"),
+			},
+		},
+	}
+
+	t.Run("returns the private network the server is a member of", func(t *testing.T) {
+		got := findPrivateNetwork(server, "7654321")
+
+		require.NotNil(t, got)
+		assert.Equal(t, "CONFIGURING", got.GetStatus())
+	})
+
+	t.Run("returns nil if the server is not a member", func(t *testing.T) {
+		assert.Nil(t, findPrivateNetwork(server, "1111111"))
+	})
+}
+
+func Test_privateNetworkResourceModel_syncWithSDK(t *testing.T) {
+	linkSpeed := dedicatedserver.LINKSPEED__10000
+
+	model := privateNetworkResourceModel{}
+	model.syncWithSDK(dedicatedserver.PrivateNetwork{
+		Id:        dedicatedserver.PtrString("1238793"),
+		LinkSpeed: &linkSpeed,
+		Status:    dedicatedserver.PtrString("CONFIGURED"),
+		Dhcp:      dedicatedserver.PtrString("DISABLED"),
+		Subnet:    dedicatedserver.PtrString("24"),
+		VlanId:    dedicatedserver.PtrString("1912639"),
+	})
+
+	assert.Equal(t, int32(10000), model.LinkSpeed.ValueInt32())
+	assert.Equal(t, "DISABLED", model.DHCP.ValueString())
+	assert.Equal(t, "CONFIGURED", model.Status.ValueString())
+	assert.Equal(t, "24", model.Subnet.ValueString())
+	assert.Equal(t, "1912639", model.VlanID.ValueString())
+}
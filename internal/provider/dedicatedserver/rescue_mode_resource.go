@@ -0,0 +1,234 @@
+package dedicatedserver
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource              = &rescueModeResource{}
+	_ resource.ResourceWithConfigure = &rescueModeResource{}
+)
+
+type rescueModeResource struct {
+	utils.ResourceAPI
+}
+
+type rescueModeResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	DedicatedServerID types.String   `tfsdk:"dedicated_server_id"`
+	CallbackURL       types.String   `tfsdk:"callback_url"`
+	Password          types.String   `tfsdk:"password"`
+	PostInstallScript types.String   `tfsdk:"post_install_script"`
+	PowerCycle        types.Bool     `tfsdk:"power_cycle"`
+	RescueImageID     types.String   `tfsdk:"rescue_image_id"`
+	SSHKeys           []types.String `tfsdk:"ssh_keys"`
+}
+
+func (r rescueModeResourceModel) generateOpts() dedicatedserver.EnableRescueModeOpts {
+	// Preparing SSH keys for the rescue mode options, combining them into a single string
+	var SSHKeysList []string
+	for _, k := range r.SSHKeys {
+		if utils.AdaptStringPointerValueToNullableString(k) != nil {
+			SSHKeysList = append(SSHKeysList, k.ValueString())
+		}
+	}
+	SSHKeys := strings.Join(SSHKeysList, "\n")
+
+	opts := dedicatedserver.NewEnableRescueModeOpts(r.RescueImageID.ValueString())
+	opts.CallbackUrl = utils.AdaptStringPointerValueToNullableString(r.CallbackURL)
+	opts.Password = utils.AdaptStringPointerValueToNullableString(r.Password)
+	opts.PowerCycle = utils.AdaptBoolPointerValueToNullableBool(r.PowerCycle)
+	if !r.PostInstallScript.IsNull() && !r.PostInstallScript.IsUnknown() {
+		opts.PostInstallScript = utils.AdaptStringValueToNullableString(base64.StdEncoding.EncodeToString([]byte(strings.TrimSpace(r.PostInstallScript.ValueString()))))
+	}
+	if len(SSHKeysList) > 0 {
+		opts.SshKeys = &SSHKeys
+	}
+
+	return *opts
+}
+
+func NewRescueModeResource() resource.Resource {
+	return &rescueModeResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_rescue_mode",
+		},
+	}
+}
+
+func (r *rescueModeResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Launches rescue mode on a dedicated server. Destroying the resource power cycles the server back into its installed operating system.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the rescue mode job",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callback_url": schema.StringAttribute{
+				Description: "Url which will receive callbacks when rescue mode is launched or failed",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Rescue mode password. If not provided, it would be automatically generated",
+				Optional:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"post_install_script": schema.StringAttribute{
+				Description: "A valid bash script to run right after rescue mode is launched.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"power_cycle": schema.BoolAttribute{
+				Description: "If true, the server is power cycled in order to boot into rescue mode. Otherwise, you should reboot the server manually. Defaults to `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"rescue_image_id": schema.StringAttribute{
+				Description: "Rescue image identifier, i.e.: *GRML* or *FREEBSD*. Use the `leaseweb_dedicated_server_rescue_images` data source to list the available images",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_keys": schema.SetAttribute{
+				Description: "List of public sshKeys to be setup in rescue mode",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *rescueModeResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan rescueModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := plan.generateOpts()
+
+	serverID := plan.DedicatedServerID.ValueString()
+	rescueModeJob, response, err := r.DedicatedserverAPI.EnableRescueMode(ctx, serverID).
+		EnableRescueModeOpts(opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	plan.ID = types.StringValue(rescueModeJob.GetUuid())
+
+	_, response, err = waitForJobAndRetrieveUntilFinished(ctx, r.DedicatedserverAPI, serverID, rescueModeJob.GetUuid())
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *rescueModeResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state rescueModeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, response, err := r.DedicatedserverAPI.GetJob(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+		state.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		// The job has been purged, so rescue mode has to be launched again.
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *rescueModeResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+func (r *rescueModeResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state rescueModeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rescue mode only lasts until the next reboot, so a power cycle brings
+	// the server back into its installed operating system.
+	response, err := r.DedicatedserverAPI.PowerCycle(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+	}
+}
@@ -0,0 +1,183 @@
+package dedicatedserver
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// rescueModeAPI fakes the job and power cycle endpoints of the dedicated
+// server API.
+type rescueModeAPI struct {
+	dedicatedserver.DedicatedserverAPI
+	jobNotFound     bool
+	powerCycledIDs  []string
+	powerCycleError error
+}
+
+func (r *rescueModeAPI) GetJob(_ context.Context, _ string, _ string) dedicatedserver.ApiGetJobRequest {
+	return dedicatedserver.ApiGetJobRequest{ApiService: r}
+}
+
+func (r *rescueModeAPI) GetJobExecute(_ dedicatedserver.ApiGetJobRequest) (*dedicatedserver.CurrentJob, *http.Response, error) {
+	if r.jobNotFound {
+		return nil, &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, errors.New("not found")
+	}
+
+	return &dedicatedserver.CurrentJob{Status: dedicatedserver.PtrString("FINISHED")}, nil, nil
+}
+
+func (r *rescueModeAPI) PowerCycle(_ context.Context, serverID string) dedicatedserver.ApiPowerCycleRequest {
+	r.powerCycledIDs = append(r.powerCycledIDs, serverID)
+
+	return dedicatedserver.ApiPowerCycleRequest{ApiService: r}
+}
+
+func (r *rescueModeAPI) PowerCycleExecute(_ dedicatedserver.ApiPowerCycleRequest) (*http.Response, error) {
+	if r.powerCycleError != nil {
+		return &http.Response{StatusCode: http.StatusConflict, Body: http.NoBody}, r.powerCycleError
+	}
+
+	return nil, nil
+}
+
+func newRescueModeState(t *testing.T, r resource.Resource) tfsdk.State {
+	t.Helper()
+
+	schemaResponse := resource.SchemaResponse{}
+	r.Schema(context.TODO(), resource.SchemaRequest{}, &schemaResponse)
+	objectType := schemaResponse.Schema.Type().TerraformType(context.TODO())
+
+	return tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":                  tftypes.NewValue(tftypes.String, "jobId"),
+			"dedicated_server_id": tftypes.NewValue(tftypes.String, "12345"),
+			"callback_url":        tftypes.NewValue(tftypes.String, nil),
+			"password":            tftypes.NewValue(tftypes.String, nil),
+			"post_install_script": tftypes.NewValue(tftypes.String, nil),
+			"power_cycle":         tftypes.NewValue(tftypes.Bool, true),
+			"rescue_image_id":     tftypes.NewValue(tftypes.String, "GRML"),
+			"ssh_keys":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		}),
+	}
+}
+
+func Test_rescueModeResourceModel_generateOpts(t *testing.T) {
+	t.Run("required fields are set", func(t *testing.T) {
+		rescueMode := rescueModeResourceModel{
+			RescueImageID:     types.StringValue("GRML"),
+			PostInstallScript: types.StringNull(),
+		}
+
+		got := rescueMode.generateOpts()
+
+		assert.Equal(t, "GRML", got.RescueImageId)
+		assert.Nil(t, got.SshKeys)
+		assert.Nil(t, got.PostInstallScript)
+	})
+
+	t.Run("ssh keys are joined", func(t *testing.T) {
+		rescueMode := rescueModeResourceModel{
+			RescueImageID: types.StringValue("GRML"),
+			SSHKeys: []types.String{
+				types.StringValue("ssh-ed25519 AAAA first"),
+				types.StringNull(),
+				types.StringValue("ssh-ed25519 BBBB second"),
+			},
+		}
+
+		got := rescueMode.generateOpts()
+
+		assert.Equal(t, "ssh-ed25519 AAAA first\nssh-ed25519 BBBB second", *got.SshKeys)
+	})
+
+	t.Run("post install script is base64 encoded", func(t *testing.T) {
+		rescueMode := rescueModeResourceModel{
+			RescueImageID:     types.StringValue("GRML"),
+			PostInstallScript: types.StringValue("\n#!/bin/bash\necho hello\n"),
+		}
+
+		got := rescueMode.generateOpts()
+
+		assert.Equal(
+			t,
+			base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\necho hello")),
+			*got.PostInstallScript,
+		)
+	})
+
+	t.Run("optional fields are set", func(t *testing.T) {
+		rescueMode := rescueModeResourceModel{
+			RescueImageID: types.StringValue("GRML"),
+			CallbackURL:   types.StringValue("https://example.com/callback"),
+			Password:      types.StringValue("secret"),
+			PowerCycle:    types.BoolValue(false),
+		}
+
+		got := rescueMode.generateOpts()
+
+		assert.Equal(t, "https://example.com/callback", *got.CallbackUrl)
+		assert.Equal(t, "secret", *got.Password)
+		assert.False(t, *got.PowerCycle)
+	})
+}
+
+func Test_rescueModeResource_Read(t *testing.T) {
+	t.Run("removes the resource if the job has been purged", func(t *testing.T) {
+		api := &rescueModeAPI{jobNotFound: true}
+		r := &rescueModeResource{ResourceAPI: utils.ResourceAPI{DedicatedserverAPI: api}}
+		state := newRescueModeState(t, r)
+		resp := resource.ReadResponse{State: state}
+
+		r.Read(context.TODO(), resource.ReadRequest{State: state}, &resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.True(t, resp.State.Raw.IsNull())
+	})
+
+	t.Run("keeps the resource if the job exists", func(t *testing.T) {
+		api := &rescueModeAPI{}
+		r := &rescueModeResource{ResourceAPI: utils.ResourceAPI{DedicatedserverAPI: api}}
+		state := newRescueModeState(t, r)
+		resp := resource.ReadResponse{State: state}
+
+		r.Read(context.TODO(), resource.ReadRequest{State: state}, &resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.False(t, resp.State.Raw.IsNull())
+	})
+}
+
+func Test_rescueModeResource_Delete(t *testing.T) {
+	t.Run("power cycles the server", func(t *testing.T) {
+		api := &rescueModeAPI{}
+		r := &rescueModeResource{ResourceAPI: utils.ResourceAPI{DedicatedserverAPI: api}}
+		resp := resource.DeleteResponse{}
+
+		r.Delete(context.TODO(), resource.DeleteRequest{State: newRescueModeState(t, r)}, &resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Equal(t, []string{"12345"}, api.powerCycledIDs)
+	})
+
+	t.Run("sets an error if the power cycle fails", func(t *testing.T) {
+		api := &rescueModeAPI{powerCycleError: errors.New("conflict")}
+		r := &rescueModeResource{ResourceAPI: utils.ResourceAPI{DedicatedserverAPI: api}}
+		resp := resource.DeleteResponse{}
+
+		r.Delete(context.TODO(), resource.DeleteRequest{State: newRescueModeState(t, r)}, &resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
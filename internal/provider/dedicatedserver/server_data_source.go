@@ -2,10 +2,12 @@ package dedicatedserver
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
@@ -19,55 +21,54 @@ type serverDataSource struct {
 }
 
 type serverDataSourceModel struct {
-	ID                                 types.String `tfsdk:"id"`
-	AssetID                            types.String `tfsdk:"asset_id"`
-	ContractID                         types.String `tfsdk:"contract_id"`
-	CPUQuantity                        types.Int32  `tfsdk:"cpu_quantity"`
-	CPUType                            types.String `tfsdk:"cpu_type"`
-	InternalGateway                    types.String `tfsdk:"internal_gateway"`
-	InternalIP                         types.String `tfsdk:"internal_ip"`
-	InternalMAC                        types.String `tfsdk:"internal_mac"`
-	IsAutomationFeatureAvailable       types.Bool   `tfsdk:"is_automation_feature_available"`
-	IsIPMIRebootFeatureAvailable       types.Bool   `tfsdk:"is_ipmi_reboot_feature_available"`
-	IsPowerCycleFeatureAvailable       types.Bool   `tfsdk:"is_power_cycle_feature_available"`
-	IsPrivateNetworkFeatureAvailable   types.Bool   `tfsdk:"is_private_network_feature_available"`
-	IsRemoteManagementFeatureAvailable types.Bool   `tfsdk:"is_remote_management_feature_available"`
-	LocationRack                       types.String `tfsdk:"location_rack"`
-	LocationSite                       types.String `tfsdk:"location_site"`
-	LocationSuite                      types.String `tfsdk:"location_suite"`
-	LocationUnit                       types.String `tfsdk:"location_unit"`
-	PublicGateway                      types.String `tfsdk:"public_gateway"`
-	PublicIP                           types.String `tfsdk:"public_ip"`
-	PublicMAC                          types.String `tfsdk:"public_mac"`
-	RackCapacity                       types.String `tfsdk:"rack_capacity"`
-	RackID                             types.String `tfsdk:"rack_id"`
-	RackType                           types.String `tfsdk:"rack_type"`
-	RAMSize                            types.Int32  `tfsdk:"ram_size"`
-	RAMUnit                            types.String `tfsdk:"ram_unit"`
-	RemoteGateway                      types.String `tfsdk:"remote_gateway"`
-	RemoteIP                           types.String `tfsdk:"remote_ip"`
-	RemoteMAC                          types.String `tfsdk:"remote_mac"`
-	SerialNumber                       types.String `tfsdk:"serial_number"`
+	ID                                 types.String   `tfsdk:"id"`
+	AssetID                            types.String   `tfsdk:"asset_id"`
+	Contract                           *contractModel `tfsdk:"contract"`
+	ContractID                         types.String   `tfsdk:"contract_id"`
+	CPUQuantity                        types.Int32    `tfsdk:"cpu_quantity"`
+	CPUType                            types.String   `tfsdk:"cpu_type"`
+	InternalGateway                    types.String   `tfsdk:"internal_gateway"`
+	InternalIP                         types.String   `tfsdk:"internal_ip"`
+	InternalMAC                        types.String   `tfsdk:"internal_mac"`
+	IsAutomationFeatureAvailable       types.Bool     `tfsdk:"is_automation_feature_available"`
+	IsIPMIRebootFeatureAvailable       types.Bool     `tfsdk:"is_ipmi_reboot_feature_available"`
+	IsPowerCycleFeatureAvailable       types.Bool     `tfsdk:"is_power_cycle_feature_available"`
+	IsPrivateNetworkFeatureAvailable   types.Bool     `tfsdk:"is_private_network_feature_available"`
+	IsRemoteManagementFeatureAvailable types.Bool     `tfsdk:"is_remote_management_feature_available"`
+	LocationRack                       types.String   `tfsdk:"location_rack"`
+	LocationSite                       types.String   `tfsdk:"location_site"`
+	LocationSuite                      types.String   `tfsdk:"location_suite"`
+	LocationUnit                       types.String   `tfsdk:"location_unit"`
+	PublicGateway                      types.String   `tfsdk:"public_gateway"`
+	PublicIP                           types.String   `tfsdk:"public_ip"`
+	PublicMAC                          types.String   `tfsdk:"public_mac"`
+	PoweredOn                          types.Bool     `tfsdk:"powered_on"`
+	RackCapacity                       types.String   `tfsdk:"rack_capacity"`
+	RackID                             types.String   `tfsdk:"rack_id"`
+	RackType                           types.String   `tfsdk:"rack_type"`
+	Reference                          types.String   `tfsdk:"reference"`
+	RAMSize                            types.Int32    `tfsdk:"ram_size"`
+	RAMUnit                            types.String   `tfsdk:"ram_unit"`
+	RemoteGateway                      types.String   `tfsdk:"remote_gateway"`
+	RemoteIP                           types.String   `tfsdk:"remote_ip"`
+	RemoteMAC                          types.String   `tfsdk:"remote_mac"`
+	SerialNumber                       types.String   `tfsdk:"serial_number"`
 }
 
-func (s *serverDataSource) Read(
-	ctx context.Context,
-	req datasource.ReadRequest,
-	resp *datasource.ReadResponse,
-) {
-	var config serverDataSourceModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
-
-	request := s.DedicatedserverAPI.GetServer(ctx, config.ID.ValueString())
-	result, response, err := request.Execute()
-	if err != nil {
-		utils.SdkError(ctx, &resp.Diagnostics, err, response)
-		return
-	}
+// serverDetailsDataSourceModel is the model of the dedicated server data
+// source, which only retrieves the power state on request.
+type serverDetailsDataSourceModel struct {
+	serverDataSourceModel
+	IncludePowerState types.Bool `tfsdk:"include_power_state"`
+}
 
-	var contractID *string
+// adaptServerToServerDataSource converts a server of the SDK to the data
+// source model. The power state is not part of the server and is left null.
+func adaptServerToServerDataSource(result dedicatedserver.Server) serverDataSourceModel {
+	var contractID, reference *string
 	if contract, ok := result.GetContractOk(); ok {
 		contractID, _ = contract.GetIdOk()
+		reference, _ = contract.GetReferenceOk()
 	}
 
 	var rackID, rackCapacity, rackType *string
@@ -143,42 +144,98 @@ func (s *serverDataSource) Read(
 		}
 	}
 
-	resp.Diagnostics.Append(
-		resp.State.Set(
-			ctx,
-			serverDataSourceModel{
-				ID:                                 types.StringValue(result.GetId()),
-				AssetID:                            types.StringValue(result.GetAssetId()),
-				ContractID:                         types.StringPointerValue(contractID),
-				CPUQuantity:                        types.Int32PointerValue(cpuQuantity),
-				CPUType:                            types.StringPointerValue(cpuType),
-				InternalGateway:                    types.StringPointerValue(internalGateway),
-				InternalIP:                         types.StringPointerValue(internalIP),
-				InternalMAC:                        types.StringPointerValue(internalMAC),
-				SerialNumber:                       types.StringValue(result.GetSerialNumber()),
-				IsAutomationFeatureAvailable:       types.BoolPointerValue(automation),
-				IsIPMIRebootFeatureAvailable:       types.BoolPointerValue(ipmiReboot),
-				IsPowerCycleFeatureAvailable:       types.BoolPointerValue(powerCycle),
-				IsPrivateNetworkFeatureAvailable:   types.BoolPointerValue(privateNetwork),
-				IsRemoteManagementFeatureAvailable: types.BoolPointerValue(remoteManagement),
-				LocationRack:                       types.StringPointerValue(locationRack),
-				LocationSite:                       types.StringPointerValue(locationSite),
-				LocationSuite:                      types.StringPointerValue(locationSuite),
-				LocationUnit:                       types.StringPointerValue(locationUnit),
-				PublicGateway:                      types.StringPointerValue(publicGateway),
-				PublicIP:                           types.StringPointerValue(publicIP),
-				PublicMAC:                          types.StringPointerValue(publicMAC),
-				RackCapacity:                       types.StringPointerValue(rackCapacity),
-				RackID:                             types.StringPointerValue(rackID),
-				RackType:                           types.StringPointerValue(rackType),
-				RAMSize:                            types.Int32PointerValue(ramSize),
-				RAMUnit:                            types.StringPointerValue(ramUnit),
-				RemoteGateway:                      types.StringPointerValue(remoteGateway),
-				RemoteIP:                           types.StringPointerValue(remoteIP),
-				RemoteMAC:                          types.StringPointerValue(remoteMAC),
-			},
-		)...,
-	)
+	return serverDataSourceModel{
+		ID:                                 types.StringValue(result.GetId()),
+		AssetID:                            types.StringValue(result.GetAssetId()),
+		Contract:                           adaptContractToContractModel(result.Contract),
+		ContractID:                         types.StringPointerValue(contractID),
+		CPUQuantity:                        types.Int32PointerValue(cpuQuantity),
+		CPUType:                            types.StringPointerValue(cpuType),
+		InternalGateway:                    types.StringPointerValue(internalGateway),
+		InternalIP:                         types.StringPointerValue(internalIP),
+		InternalMAC:                        types.StringPointerValue(internalMAC),
+		SerialNumber:                       types.StringValue(result.GetSerialNumber()),
+		IsAutomationFeatureAvailable:       types.BoolPointerValue(automation),
+		IsIPMIRebootFeatureAvailable:       types.BoolPointerValue(ipmiReboot),
+		IsPowerCycleFeatureAvailable:       types.BoolPointerValue(powerCycle),
+		IsPrivateNetworkFeatureAvailable:   types.BoolPointerValue(privateNetwork),
+		IsRemoteManagementFeatureAvailable: types.BoolPointerValue(remoteManagement),
+		LocationRack:                       types.StringPointerValue(locationRack),
+		LocationSite:                       types.StringPointerValue(locationSite),
+		LocationSuite:                      types.StringPointerValue(locationSuite),
+		LocationUnit:                       types.StringPointerValue(locationUnit),
+		PublicGateway:                      types.StringPointerValue(publicGateway),
+		PublicIP:                           types.StringPointerValue(publicIP),
+		PublicMAC:                          types.StringPointerValue(publicMAC),
+		RackCapacity:                       types.StringPointerValue(rackCapacity),
+		RackID:                             types.StringPointerValue(rackID),
+		RackType:                           types.StringPointerValue(rackType),
+		RAMSize:                            types.Int32PointerValue(ramSize),
+		RAMUnit:                            types.StringPointerValue(ramUnit),
+		RemoteGateway:                      types.StringPointerValue(remoteGateway),
+		RemoteIP:                           types.StringPointerValue(remoteIP),
+		RemoteMAC:                          types.StringPointerValue(remoteMAC),
+		PoweredOn:                          types.BoolNull(),
+		Reference:                          types.StringPointerValue(reference),
+	}
+}
+
+func (s *serverDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config serverDetailsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := s.DedicatedserverAPI.GetServer(ctx, config.ID.ValueString())
+	result, response, err := request.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state := serverDetailsDataSourceModel{
+		serverDataSourceModel: adaptServerToServerDataSource(*result),
+		IncludePowerState:     config.IncludePowerState,
+	}
+
+	if config.IncludePowerState.ValueBool() {
+		poweredOn, response, err := getPoweredOn(ctx, s.DedicatedserverAPI, state.ID.ValueString())
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+		state.PoweredOn = types.BoolValue(poweredOn)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// getPoweredOn returns whether the server is powered on.
+func getPoweredOn(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+) (bool, *http.Response, error) {
+	result, response, err := api.GetPowerStatus(ctx, serverID).Execute()
+	if err != nil {
+		return false, response, err
+	}
+
+	return isPoweredOn(*result), response, nil
+}
+
+// isPoweredOn returns whether the server is powered on according to both
+// the PDU and IPMI.
+func isPoweredOn(powerStatus dedicatedserver.GetPowerStatusResult) bool {
+	pdu := powerStatus.GetPdu()
+	ipmi := powerStatus.GetIpmi()
+
+	return pdu.GetStatus() != "off" && ipmi.GetStatus() != "off"
 }
 
 func (s *serverDataSource) Schema(
@@ -186,124 +243,202 @@ func (s *serverDataSource) Schema(
 	_ datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
+	attributes := serverDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the server.",
+	}
+	attributes["include_power_state"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "Retrieve the power state of the server. This requires an additional API call.",
+	}
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "The unique identifier of the server.",
-			},
-			"asset_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The Asset ID of the server.",
-			},
-			"serial_number": schema.StringAttribute{
-				Computed:    true,
-				Description: "Serial number of server.",
-			},
-			"contract_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unique identifier of the contract.",
-			},
-			"rack_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The ID of the rack.",
-			},
-			"rack_capacity": schema.StringAttribute{
-				Computed:    true,
-				Description: "The capacity of the rack.",
-			},
-			"rack_type": schema.StringAttribute{
-				Computed:    true,
-				Description: "The type of the rack.",
-			},
-			"is_automation_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if automation feature is available for the server.",
-			},
-			"is_ipmi_reboot_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if ipmi_reboot feature is available for the server.",
-			},
-			"is_power_cycle_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if power_cycle feature is available for the server.",
-			},
-			"is_private_network_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if private network feature is available for the server.",
-			},
-			"is_remote_management_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if remote management feature is available for the server.",
-			},
-			"location_rack": schema.StringAttribute{
-				Computed: true,
-			},
-			"location_site": schema.StringAttribute{
-				Computed:    true,
-				Description: "The site of the location.",
-			},
-			"location_suite": schema.StringAttribute{
-				Computed:    true,
-				Description: "The suite of the location.",
-			},
-			"location_unit": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unit of the location.",
-			},
-			"public_mac": schema.StringAttribute{
-				Computed:    true,
-				Description: "Public mac address.",
-			},
-			"public_ip": schema.StringAttribute{
-				Computed:    true,
-				Description: "Public ip address.",
-			},
-			"public_gateway": schema.StringAttribute{
-				Computed:    true,
-				Description: "Public gateway.",
-			},
-			"internal_mac": schema.StringAttribute{
-				Computed:    true,
-				Description: "Internal mac address.",
-			},
-			"internal_ip": schema.StringAttribute{
-				Computed:    true,
-				Description: "Internal ip address.",
-			},
-			"internal_gateway": schema.StringAttribute{
-				Computed:    true,
-				Description: "Internal gateway.",
-			},
-			"remote_mac": schema.StringAttribute{
-				Computed:    true,
-				Description: "Remote mac address.",
-			},
-			"remote_ip": schema.StringAttribute{
-				Computed:    true,
-				Description: "Remote ip address.",
-			},
-			"remote_gateway": schema.StringAttribute{
-				Computed:    true,
-				Description: "Remote gateway.",
-			},
-			"ram_size": schema.Int32Attribute{
-				Computed:    true,
-				Description: "The size of the ram.",
-			},
-			"ram_unit": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unit of the ram.",
-			},
-			"cpu_quantity": schema.Int32Attribute{
-				Computed:    true,
-				Description: "The quantity of the cpu.",
-			},
-			"cpu_type": schema.StringAttribute{
-				Computed:    true,
-				Description: "The type of the cpu.",
+		Attributes: attributes,
+	}
+}
+
+// serverDataSourceAttributes returns the attributes of a server, shared by
+// the dedicated server and dedicated servers data sources.
+func serverDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the server.",
+		},
+		"reference": schema.StringAttribute{
+			Computed:    true,
+			Description: "The reference of the server.",
+		},
+		"powered_on": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the server is powered on. Only set when `include_power_state` is `true`.",
+		},
+		"asset_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The Asset ID of the server.",
+		},
+		"serial_number": schema.StringAttribute{
+			Computed:    true,
+			Description: "Serial number of server.",
+		},
+		"contract_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the contract.",
+		},
+		"contract": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "The contract of the server.",
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					Description: "The unique identifier of the contract.",
+				},
+				"aggregation_pack_id": schema.StringAttribute{
+					Computed:    true,
+					Description: "The ID of the aggregation pack the contract belongs to.",
+				},
+				"billing_cycle": schema.Int32Attribute{
+					Computed:    true,
+					Description: "The billing cycle in months.",
+				},
+				"billing_frequency": schema.StringAttribute{
+					Computed:    true,
+					Description: "The billing frequency, i.e. *MONTH*.",
+				},
+				"contract_term": schema.Int32Attribute{
+					Computed:    true,
+					Description: "The contract term in months.",
+				},
+				"contract_type": schema.StringAttribute{
+					Computed:    true,
+					Description: "The type of the contract, i.e. *NORMAL*.",
+				},
+				"currency": schema.StringAttribute{
+					Computed:    true,
+					Description: "The currency of the price.",
+				},
+				"ends_at": schema.StringAttribute{
+					Computed:    true,
+					Description: "The end date of the contract. Empty if the contract is renewed automatically.",
+				},
+				"price_per_frequency": schema.StringAttribute{
+					Computed:    true,
+					Description: "The price per billing frequency.",
+				},
+				"sla": schema.StringAttribute{
+					Computed:    true,
+					Description: "The service level agreement.",
+				},
+				"starts_at": schema.StringAttribute{
+					Computed:    true,
+					Description: "The start date of the contract.",
+				},
+				"status": schema.StringAttribute{
+					Computed:    true,
+					Description: "The status of the contract.",
+				},
 			},
 		},
+		"rack_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the rack.",
+		},
+		"rack_capacity": schema.StringAttribute{
+			Computed:    true,
+			Description: "The capacity of the rack.",
+		},
+		"rack_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the rack.",
+		},
+		"is_automation_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if automation feature is available for the server.",
+		},
+		"is_ipmi_reboot_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if ipmi_reboot feature is available for the server.",
+		},
+		"is_power_cycle_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if power_cycle feature is available for the server.",
+		},
+		"is_private_network_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if private network feature is available for the server.",
+		},
+		"is_remote_management_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if remote management feature is available for the server.",
+		},
+		"location_rack": schema.StringAttribute{
+			Computed: true,
+		},
+		"location_site": schema.StringAttribute{
+			Computed:    true,
+			Description: "The site of the location.",
+		},
+		"location_suite": schema.StringAttribute{
+			Computed:    true,
+			Description: "The suite of the location.",
+		},
+		"location_unit": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unit of the location.",
+		},
+		"public_mac": schema.StringAttribute{
+			Computed:    true,
+			Description: "Public mac address.",
+		},
+		"public_ip": schema.StringAttribute{
+			Computed:    true,
+			Description: "Public ip address.",
+		},
+		"public_gateway": schema.StringAttribute{
+			Computed:    true,
+			Description: "Public gateway.",
+		},
+		"internal_mac": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal mac address.",
+		},
+		"internal_ip": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal ip address.",
+		},
+		"internal_gateway": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal gateway.",
+		},
+		"remote_mac": schema.StringAttribute{
+			Computed:    true,
+			Description: "Remote mac address.",
+		},
+		"remote_ip": schema.StringAttribute{
+			Computed:    true,
+			Description: "Remote ip address.",
+		},
+		"remote_gateway": schema.StringAttribute{
+			Computed:    true,
+			Description: "Remote gateway.",
+		},
+		"ram_size": schema.Int32Attribute{
+			Computed:    true,
+			Description: "The size of the ram.",
+		},
+		"ram_unit": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unit of the ram.",
+		},
+		"cpu_quantity": schema.Int32Attribute{
+			Computed:    true,
+			Description: "The quantity of the cpu.",
+		},
+		"cpu_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the cpu.",
+		},
 	}
 }
 
@@ -0,0 +1,47 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptServerToServerDataSource(t *testing.T) {
+	id := "12345"
+	contractID := "674382"
+	reference := "database.server"
+	site := "AMS-01"
+	rackType := dedicatedserver.RACKTYPE_SHARED
+
+	got := adaptServerToServerDataSource(dedicatedserver.Server{
+		Id: &id,
+		Contract: &dedicatedserver.Contract{
+			Id:        &contractID,
+			Reference: *dedicatedserver.NewNullableString(&reference),
+		},
+		Location: &dedicatedserver.Location{Site: &site},
+		Rack:     &dedicatedserver.Rack{Type: &rackType},
+	})
+
+	assert.Equal(t, "12345", got.ID.ValueString())
+	assert.Equal(t, "674382", got.ContractID.ValueString())
+	assert.Equal(t, "database.server", got.Reference.ValueString())
+	assert.Equal(t, "AMS-01", got.LocationSite.ValueString())
+	assert.Equal(t, "SHARED", got.RackType.ValueString())
+	assert.True(t, got.PublicIP.IsNull())
+	assert.True(t, got.PoweredOn.IsNull())
+}
+
+func Test_isPoweredOn(t *testing.T) {
+	powerStatus := func(ipmiStatus string, pduStatus string) dedicatedserver.GetPowerStatusResult {
+		return dedicatedserver.GetPowerStatusResult{
+			Ipmi: &dedicatedserver.Ipmi{Status: &ipmiStatus},
+			Pdu:  &dedicatedserver.Pdu{Status: &pduStatus},
+		}
+	}
+
+	assert.True(t, isPoweredOn(powerStatus("on", "on")))
+	assert.False(t, isPoweredOn(powerStatus("off", "on")))
+	assert.False(t, isPoweredOn(powerStatus("on", "off")))
+}
@@ -2,20 +2,26 @@ package dedicatedserver
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
@@ -32,6 +38,9 @@ type serverResource struct {
 
 type serverResourceModel struct {
 	ID                           types.String `tfsdk:"id"`
+	ServerID                     types.String `tfsdk:"server_id"`
+	AssetID                      types.String `tfsdk:"asset_id"`
+	SerialNumber                 types.String `tfsdk:"serial_number"`
 	Reference                    types.String `tfsdk:"reference"`
 	ReverseLookup                types.String `tfsdk:"reverse_lookup"`
 	DHCPLease                    types.String `tfsdk:"dhcp_lease"`
@@ -42,6 +51,16 @@ type serverResourceModel struct {
 	RemoteManagementIP           types.String `tfsdk:"remote_management_ip"`
 	InternalMAC                  types.String `tfsdk:"internal_mac"`
 	Location                     types.Object `tfsdk:"location"`
+	Contract                     types.Object `tfsdk:"contract"`
+	OnDestroy                    types.Object `tfsdk:"on_destroy"`
+}
+
+type onDestroyResourceModel struct {
+	ResetReference              types.Bool `tfsdk:"reset_reference"`
+	PowerOff                    types.Bool `tfsdk:"power_off"`
+	ClosePublicNetworkInterface types.Bool `tfsdk:"close_public_network_interface"`
+	RemoveDHCPReservation       types.Bool `tfsdk:"remove_dhcp_reservation"`
+	NullRoutePublicIP           types.Bool `tfsdk:"null_route_public_ip"`
 }
 
 type locationResourceModel struct {
@@ -64,6 +83,20 @@ func (s *serverResource) Schema(
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
+	// Adding a lookup to an imported server or removing the lookup of an
+	// adopted server must not replace it, only changing the lookup does.
+	requiresReplaceIfSet := stringplanmodifier.RequiresReplaceIf(
+		func(
+			_ context.Context,
+			req planmodifier.StringRequest,
+			resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsNull()
+		},
+		"Changing the lookup of an adopted server requires replacement.",
+		"Changing the lookup of an adopted server requires replacement.",
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -73,6 +106,39 @@ func (s *serverResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the existing server to adopt on create. Conflicts with `asset_id` and `serial_number`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("asset_id"),
+						path.MatchRoot("serial_number"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{requiresReplaceIfSet},
+			},
+			"asset_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The asset ID of the existing server to adopt on create. Conflicts with `server_id` and `serial_number`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("server_id"),
+						path.MatchRoot("serial_number"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{requiresReplaceIfSet},
+			},
+			"serial_number": schema.StringAttribute{
+				Optional:    true,
+				Description: "The serial number of the existing server to adopt on create. Conflicts with `server_id` and `asset_id`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("server_id"),
+						path.MatchRoot("asset_id"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{requiresReplaceIfSet},
+			},
 			"reference": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -94,7 +160,7 @@ func (s *serverResource) Schema(
 			"powered_on": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Whether the dedicated server is powered on or not.",
+				Description: "Whether the dedicated server is powered on or not. After a change, Terraform waits up to 5 minutes for the server to report the new power state.",
 			},
 			"public_network_interface_opened": schema.BoolAttribute{
 				Optional:    true,
@@ -151,12 +217,105 @@ func (s *serverResource) Schema(
 					objectplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"contract": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The contract of the dedicated server.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the contract.",
+					},
+					"aggregation_pack_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the aggregation pack the contract belongs to.",
+					},
+					"billing_cycle": schema.Int32Attribute{
+						Computed:    true,
+						Description: "The billing cycle in months.",
+					},
+					"billing_frequency": schema.StringAttribute{
+						Computed:    true,
+						Description: "The billing frequency, i.e. *MONTH*.",
+					},
+					"contract_term": schema.Int32Attribute{
+						Computed:    true,
+						Description: "The contract term in months.",
+					},
+					"contract_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "The type of the contract, i.e. *NORMAL*.",
+					},
+					"currency": schema.StringAttribute{
+						Computed:    true,
+						Description: "The currency of the price.",
+					},
+					"ends_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The end date of the contract. Empty if the contract is renewed automatically.",
+					},
+					"price_per_frequency": schema.StringAttribute{
+						Computed:    true,
+						Description: "The price per billing frequency.",
+					},
+					"sla": schema.StringAttribute{
+						Computed:    true,
+						Description: "The service level agreement.",
+					},
+					"starts_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The start date of the contract.",
+					},
+					"status": schema.StringAttribute{
+						Computed:    true,
+						Description: "The status of the contract.",
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"on_destroy": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Actions to take when the resource is destroyed, so a decommissioned server is left in a known state. Without this block destroying the resource only removes it from the Terraform state.",
+				Attributes: map[string]schema.Attribute{
+					"reset_reference": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether to reset the reference of the dedicated server to empty.",
+					},
+					"power_off": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether to power off the dedicated server. Terraform waits up to 5 minutes for the server to be powered off.",
+					},
+					"close_public_network_interface": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether to close the public network interface of the dedicated server.",
+					},
+					"remove_dhcp_reservation": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether to remove the DHCP reservation of the dedicated server.",
+					},
+					"null_route_public_ip": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether to null route the public IP of the dedicated server.",
+					},
+				},
+			},
 		},
 	}
 
 	utils.AddUnsupportedActionsNotation(
 		resp,
-		[]utils.Action{utils.CreateAction, utils.DeleteAction},
+		[]utils.Action{utils.DeleteAction},
 	)
 }
 
@@ -170,14 +329,33 @@ func (s *serverResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	server := s.getServer(ctx, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server.ServerID = state.ServerID
+	server.AssetID = state.AssetID
+	server.SerialNumber = state.SerialNumber
+	server.OnDestroy = state.OnDestroy
+	resp.Diagnostics.Append(resp.State.Set(ctx, server)...)
+}
+
+// getServer reads the current settings of the server from the API.
+func (s *serverResource) getServer(
+	ctx context.Context,
+	serverID string,
+	diags *diag.Diagnostics,
+) *serverResourceModel {
 	// Getting server info
 	server, httpResponse, err := s.DedicatedserverAPI.GetServer(
 		ctx,
-		state.ID.ValueString(),
+		serverID,
 	).Execute()
 	if err != nil {
-		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
-		return
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return nil
 	}
 
 	var publicIP string
@@ -217,7 +395,7 @@ func (s *serverResource) Read(
 	}
 
 	serverLocation := server.GetLocation()
-	location, diags := types.ObjectValueFrom(
+	location, objectDiags := types.ObjectValueFrom(
 		ctx,
 		map[string]attr.Type{
 			"rack":  types.StringType,
@@ -233,35 +411,46 @@ func (s *serverResource) Read(
 		},
 	)
 
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
+	if objectDiags.HasError() {
+		diags.Append(objectDiags...)
+		return nil
+	}
+
+	contract := types.ObjectNull(contractModel{}.attributeTypes())
+	if sdkContract, ok := server.GetContractOk(); ok {
+		contract, objectDiags = types.ObjectValueFrom(
+			ctx,
+			contractModel{}.attributeTypes(),
+			adaptContractToContractModel(sdkContract),
+		)
+		if objectDiags.HasError() {
+			diags.Append(objectDiags...)
+			return nil
+		}
 	}
 
 	// Getting server power info
 	getServerPowerStatusResult, httpResponse, err := s.DedicatedserverAPI.GetPowerStatus(
 		ctx,
-		state.ID.ValueString(),
+		serverID,
 	).Execute()
 	if err != nil {
-		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
-		return
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return nil
 	}
 
-	pdu := getServerPowerStatusResult.GetPdu()
-	ipmi := getServerPowerStatusResult.GetIpmi()
-	poweredOn := pdu.GetStatus() != "off" && ipmi.GetStatus() != "off"
+	poweredOn := isPoweredOn(*getServerPowerStatusResult)
 
 	// Getting server public network interface info
 	var publicNetworkOpened bool
 	operationNetworkInterface, httpResponse, err := s.DedicatedserverAPI.GetNetworkInterface(
 		ctx,
-		state.ID.ValueString(),
+		serverID,
 		dedicatedserver.NETWORKTYPEURL_PUBLIC,
 	).Execute()
 	if err != nil && httpResponse != nil && httpResponse.StatusCode != http.StatusNotFound {
-		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
-		return
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return nil
 	} else {
 		if operationNetworkInterface != nil {
 			if _, ok := operationNetworkInterface.GetStatusOk(); ok {
@@ -273,11 +462,11 @@ func (s *serverResource) Read(
 	// Getting server DHCP info
 	getServerDhcpReservationListResult, httpResponse, err := s.DedicatedserverAPI.GetDhcpReservationList(
 		ctx,
-		state.ID.ValueString(),
+		serverID,
 	).Execute()
 	if err != nil {
-		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
-		return
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return nil
 	}
 	var dhcpLease string
 	if len(getServerDhcpReservationListResult.GetLeases()) != 0 {
@@ -290,34 +479,30 @@ func (s *serverResource) Read(
 	if publicIP != "" {
 		ip, httpResponse, err := s.DedicatedserverAPI.GetIp(
 			ctx,
-			state.ID.ValueString(),
+			serverID,
 			publicIP,
 		).Execute()
 		if err != nil {
-			utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
-			return
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return nil
 		}
 		reverseLookup = ip.GetReverseLookup()
 	}
 
-	resp.Diagnostics.Append(
-		resp.State.Set(
-			ctx,
-			serverResourceModel{
-				ID:                           types.StringValue(server.GetId()),
-				Reference:                    types.StringValue(reference),
-				ReverseLookup:                types.StringValue(reverseLookup),
-				DHCPLease:                    types.StringValue(dhcpLease),
-				PoweredOn:                    types.BoolValue(poweredOn),
-				PublicNetworkInterfaceOpened: types.BoolValue(publicNetworkOpened),
-				PublicIPNullRouted:           types.BoolValue(publicIPNullRouted),
-				PublicIP:                     types.StringValue(publicIP),
-				RemoteManagementIP:           types.StringValue(remoteManagementIP),
-				InternalMAC:                  types.StringValue(internalMAC),
-				Location:                     location,
-			},
-		)...,
-	)
+	return &serverResourceModel{
+		ID:                           types.StringValue(server.GetId()),
+		Reference:                    types.StringValue(reference),
+		ReverseLookup:                types.StringValue(reverseLookup),
+		DHCPLease:                    types.StringValue(dhcpLease),
+		PoweredOn:                    types.BoolValue(poweredOn),
+		PublicNetworkInterfaceOpened: types.BoolValue(publicNetworkOpened),
+		PublicIPNullRouted:           types.BoolValue(publicIPNullRouted),
+		PublicIP:                     types.StringValue(publicIP),
+		RemoteManagementIP:           types.StringValue(remoteManagementIP),
+		InternalMAC:                  types.StringValue(internalMAC),
+		Location:                     location,
+		Contract:                     contract,
+	}
 }
 
 func (s *serverResource) ImportState(
@@ -350,6 +535,32 @@ func (s *serverResource) Update(
 		return
 	}
 
+	s.verifyLookup(ctx, plan, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ServerID = plan.ServerID
+	state.AssetID = plan.AssetID
+	state.SerialNumber = plan.SerialNumber
+	state.OnDestroy = plan.OnDestroy
+
+	s.update(ctx, plan, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// update applies the settings of the plan to the server. The state is
+// updated after every successful call.
+func (s *serverResource) update(
+	ctx context.Context,
+	plan serverResourceModel,
+	state *serverResourceModel,
+	diags *diag.Diagnostics,
+) {
 	// Updating reference
 	if !plan.Reference.IsNull() && !plan.Reference.IsUnknown() {
 		opts := dedicatedserver.NewUpdateReferenceOpts(plan.Reference.ValueString())
@@ -358,7 +569,7 @@ func (s *serverResource) Update(
 			state.ID.ValueString(),
 		).UpdateReferenceOpts(*opts).Execute()
 		if err != nil {
-			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			utils.SdkError(ctx, diags, err, response)
 			return
 		}
 		state.Reference = plan.Reference
@@ -370,17 +581,27 @@ func (s *serverResource) Update(
 			request := s.DedicatedserverAPI.PowerOn(ctx, state.ID.ValueString())
 			response, err := request.Execute()
 			if err != nil {
-				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				utils.SdkError(ctx, diags, err, response)
 				return
 			}
 		} else {
 			request := s.DedicatedserverAPI.PowerOff(ctx, state.ID.ValueString())
 			response, err := request.Execute()
 			if err != nil {
-				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				utils.SdkError(ctx, diags, err, response)
 				return
 			}
 		}
+
+		response, err := s.waitUntilPoweredOnEquals(
+			ctx,
+			state.ID.ValueString(),
+			plan.PoweredOn.ValueBool(),
+		)
+		if err != nil {
+			utils.SdkError(ctx, diags, err, response)
+			return
+		}
 		state.PoweredOn = plan.PoweredOn
 	}
 
@@ -395,7 +616,7 @@ func (s *serverResource) Update(
 			state.PublicIP.ValueString(),
 		).UpdateIpProfileOpts(*opts).Execute()
 		if err != nil {
-			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			utils.SdkError(ctx, diags, err, response)
 			return
 		}
 		state.ReverseLookup = plan.ReverseLookup
@@ -410,7 +631,7 @@ func (s *serverResource) Update(
 				state.PublicIP.ValueString(),
 			).Execute()
 			if err != nil {
-				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				utils.SdkError(ctx, diags, err, response)
 				return
 			}
 		} else {
@@ -420,7 +641,7 @@ func (s *serverResource) Update(
 				state.PublicIP.ValueString(),
 			).Execute()
 			if err != nil {
-				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				utils.SdkError(ctx, diags, err, response)
 				return
 			}
 		}
@@ -436,7 +657,7 @@ func (s *serverResource) Update(
 				state.ID.ValueString(),
 			).CreateDhcpReservationOpts(*opts).Execute()
 			if err != nil {
-				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				utils.SdkError(ctx, diags, err, response)
 				return
 			}
 		} else {
@@ -445,7 +666,7 @@ func (s *serverResource) Update(
 				state.ID.ValueString(),
 			).Execute()
 			if err != nil {
-				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				utils.SdkError(ctx, diags, err, response)
 				return
 			}
 		}
@@ -453,7 +674,7 @@ func (s *serverResource) Update(
 	}
 
 	// Updating network interface status
-	if !plan.PublicIPNullRouted.IsNull() && !plan.PublicIPNullRouted.IsUnknown() && plan.PublicNetworkInterfaceOpened != state.PublicNetworkInterfaceOpened {
+	if !plan.PublicNetworkInterfaceOpened.IsNull() && !plan.PublicNetworkInterfaceOpened.IsUnknown() && plan.PublicNetworkInterfaceOpened != state.PublicNetworkInterfaceOpened {
 		if plan.PublicNetworkInterfaceOpened.ValueBool() {
 			response, err := s.DedicatedserverAPI.OpenNetworkInterface(
 				ctx,
@@ -461,7 +682,7 @@ func (s *serverResource) Update(
 				dedicatedserver.NETWORKTYPEURL_PUBLIC,
 			).Execute()
 			if err != nil {
-				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				utils.SdkError(ctx, diags, err, response)
 				return
 			}
 		} else {
@@ -471,27 +692,266 @@ func (s *serverResource) Update(
 				dedicatedserver.NETWORKTYPEURL_PUBLIC,
 			).Execute()
 			if err != nil {
-				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				utils.SdkError(ctx, diags, err, response)
 				return
 			}
 		}
 		state.PublicNetworkInterfaceOpened = plan.PublicNetworkInterfaceOpened
 	}
+}
 
+func (s *serverResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan serverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Servers cannot be ordered through the API, only existing ones can be adopted.
+	if plan.ServerID.IsNull() && plan.AssetID.IsNull() && plan.SerialNumber.IsNull() {
+		utils.ImportOnlyError(&resp.Diagnostics)
+		return
+	}
+
+	serverID := s.findServerID(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := s.getServer(ctx, serverID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ServerID = plan.ServerID
+	state.AssetID = plan.AssetID
+	state.SerialNumber = plan.SerialNumber
+	state.OnDestroy = plan.OnDestroy
+
+	s.update(ctx, plan, state, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
-func (s *serverResource) Create(
-	_ context.Context,
-	_ resource.CreateRequest,
-	response *resource.CreateResponse,
+// findServerID returns the ID of the server identified by the server_id,
+// asset_id or serial_number of the plan.
+func (s *serverResource) findServerID(
+	ctx context.Context,
+	plan serverResourceModel,
+	diags *diag.Diagnostics,
+) string {
+	if !plan.ServerID.IsNull() {
+		return plan.ServerID.ValueString()
+	}
+
+	servers, response, err := getAllServers(s.DedicatedserverAPI.GetServerList(ctx))
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return ""
+	}
+
+	for _, server := range servers {
+		if serverMatchesLookup(server, plan.AssetID, plan.SerialNumber) {
+			return server.GetId()
+		}
+	}
+
+	if !plan.AssetID.IsNull() {
+		utils.ReportError(
+			fmt.Sprintf("no dedicated server found with asset ID %q", plan.AssetID.ValueString()),
+			diags,
+		)
+		return ""
+	}
+
+	utils.ReportError(
+		fmt.Sprintf("no dedicated server found with serial number %q", plan.SerialNumber.ValueString()),
+		diags,
+	)
+	return ""
+}
+
+// verifyLookup checks that a lookup added to an imported server identifies
+// the server in the state, so that the state cannot claim to manage another
+// server than the one it does.
+func (s *serverResource) verifyLookup(
+	ctx context.Context,
+	plan serverResourceModel,
+	state serverResourceModel,
+	diags *diag.Diagnostics,
 ) {
-	utils.ImportOnlyError(&response.Diagnostics)
+	if plan.ServerID.IsNull() && plan.AssetID.IsNull() && plan.SerialNumber.IsNull() {
+		return
+	}
+	if plan.ServerID.Equal(state.ServerID) &&
+		plan.AssetID.Equal(state.AssetID) &&
+		plan.SerialNumber.Equal(state.SerialNumber) {
+		return
+	}
+
+	serverID := s.findServerID(ctx, plan, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if serverID != state.ID.ValueString() {
+		utils.ReportError(
+			fmt.Sprintf(
+				"the lookup identifies dedicated server %s, but this resource manages dedicated server %s",
+				serverID,
+				state.ID.ValueString(),
+			),
+			diags,
+		)
+	}
+}
+
+func serverMatchesLookup(
+	server dedicatedserver.Server,
+	assetID types.String,
+	serialNumber types.String,
+) bool {
+	if !assetID.IsNull() {
+		return server.GetAssetId() == assetID.ValueString()
+	}
+
+	return !serialNumber.IsNull() && server.GetSerialNumber() == serialNumber.ValueString()
 }
 
 func (s *serverResource) Delete(
-	_ context.Context,
-	_ resource.DeleteRequest,
-	_ *resource.DeleteResponse,
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
 ) {
+	var state serverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.OnDestroy.IsNull() || state.OnDestroy.IsUnknown() {
+		return
+	}
+
+	var onDestroy onDestroyResourceModel
+	resp.Diagnostics.Append(
+		state.OnDestroy.As(ctx, &onDestroy, basetypes.ObjectAsOptions{})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ID.ValueString()
+	isPublicIPExists := !state.PublicIP.IsNull() && !state.PublicIP.IsUnknown() && state.PublicIP.ValueString() != ""
+
+	// Cut the server off from the internet first
+	if onDestroy.NullRoutePublicIP.ValueBool() && isPublicIPExists {
+		_, response, err := s.DedicatedserverAPI.NullIpRoute(
+			ctx,
+			serverID,
+			state.PublicIP.ValueString(),
+		).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	if onDestroy.ClosePublicNetworkInterface.ValueBool() {
+		response, err := s.DedicatedserverAPI.CloseNetworkInterface(
+			ctx,
+			serverID,
+			dedicatedserver.NETWORKTYPEURL_PUBLIC,
+		).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	// A server without a DHCP reservation responds with a 404
+	if onDestroy.RemoveDHCPReservation.ValueBool() {
+		response, err := s.DedicatedserverAPI.DeleteDhcpReservation(
+			ctx,
+			serverID,
+		).Execute()
+		if err != nil && (response == nil || response.StatusCode != http.StatusNotFound) {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	if onDestroy.ResetReference.ValueBool() {
+		opts := dedicatedserver.NewUpdateReferenceOpts("")
+		response, err := s.DedicatedserverAPI.UpdateReference(
+			ctx,
+			serverID,
+		).UpdateReferenceOpts(*opts).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	if onDestroy.PowerOff.ValueBool() {
+		response, err := s.DedicatedserverAPI.PowerOff(ctx, serverID).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+
+		response, err = s.waitUntilPoweredOnEquals(ctx, serverID, false)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		}
+	}
+}
+
+// waitUntilPoweredOnEquals polls the power status of the server until it
+// matches the expected power state.
+func (s *serverResource) waitUntilPoweredOnEquals(
+	ctx context.Context,
+	serverID string,
+	expectedPoweredOn bool,
+) (*http.Response, error) {
+	// Poll every 10 seconds for 5 minutes
+	powerStatus, response, err := waitUntil(
+		ctx,
+		10*time.Second,
+		30,
+		fmt.Sprintf("server %s to power %s", serverID, powerStateName(expectedPoweredOn)),
+		func() (*dedicatedserver.GetPowerStatusResult, bool, *http.Response, error) {
+			powerStatus, response, err := s.DedicatedserverAPI.
+				GetPowerStatus(ctx, serverID).
+				Execute()
+			if err != nil {
+				return nil, false, response, err
+			}
+
+			return powerStatus, isPoweredOn(*powerStatus) == expectedPoweredOn, response, nil
+		},
+	)
+	if errors.Is(err, errWaitTimedOut) {
+		ipmi := powerStatus.GetIpmi()
+		pdu := powerStatus.GetPdu()
+		return nil, fmt.Errorf(
+			"%w, the IPMI power state is %q and the PDU power state is %q",
+			err,
+			ipmi.GetStatus(),
+			pdu.GetStatus(),
+		)
+	}
+
+	return response, err
+}
+
+func powerStateName(poweredOn bool) string {
+	if poweredOn {
+		return "on"
+	}
+
+	return "off"
 }
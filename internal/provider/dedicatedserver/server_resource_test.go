@@ -0,0 +1,116 @@
+package dedicatedserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_serverMatchesLookup(t *testing.T) {
+	server := dedicatedserver.Server{
+		AssetId:      dedicatedserver.PtrString("627294"),
+		SerialNumber: dedicatedserver.PtrString("JDK18291JK"),
+	}
+
+	t.Run("matches on asset ID", func(t *testing.T) {
+		assert.True(t, serverMatchesLookup(server, types.StringValue("627294"), types.StringNull()))
+		assert.False(t, serverMatchesLookup(server, types.StringValue("627293"), types.StringNull()))
+	})
+
+	t.Run("matches on serial number", func(t *testing.T) {
+		assert.True(t, serverMatchesLookup(server, types.StringNull(), types.StringValue("JDK18291JK")))
+		assert.False(t, serverMatchesLookup(server, types.StringNull(), types.StringValue("other")))
+	})
+
+	t.Run("does not match without a lookup", func(t *testing.T) {
+		assert.False(t, serverMatchesLookup(server, types.StringNull(), types.StringNull()))
+	})
+}
+
+func Test_serverResource_verifyLookup(t *testing.T) {
+	newServerResource := func() (*serverResource, *serverListAPI) {
+		api := &serverListAPI{
+			pages: [][]dedicatedserver.Server{
+				{
+					{Id: dedicatedserver.PtrString("12345"), AssetId: dedicatedserver.PtrString("627294")},
+					{Id: dedicatedserver.PtrString("67890"), AssetId: dedicatedserver.PtrString("627293")},
+				},
+			},
+			totalCount: 2,
+		}
+
+		return &serverResource{ResourceAPI: utils.ResourceAPI{DedicatedserverAPI: api}}, api
+	}
+	state := serverResourceModel{
+		ID:           types.StringValue("12345"),
+		ServerID:     types.StringNull(),
+		AssetID:      types.StringNull(),
+		SerialNumber: types.StringNull(),
+	}
+
+	t.Run("accepts a lookup that identifies the server", func(t *testing.T) {
+		s, _ := newServerResource()
+		plan := state
+		plan.AssetID = types.StringValue("627294")
+		diags := diag.Diagnostics{}
+
+		s.verifyLookup(context.TODO(), plan, state, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("sets an error if the lookup identifies another server", func(t *testing.T) {
+		s, _ := newServerResource()
+		plan := state
+		plan.AssetID = types.StringValue("627293")
+		diags := diag.Diagnostics{}
+
+		s.verifyLookup(context.TODO(), plan, state, &diags)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Contains(
+			t,
+			diags.Errors()[0].Detail(),
+			"the lookup identifies dedicated server 67890, but this resource manages dedicated server 12345",
+		)
+	})
+
+	t.Run("sets an error if the server ID is another server", func(t *testing.T) {
+		s, api := newServerResource()
+		plan := state
+		plan.ServerID = types.StringValue("67890")
+		diags := diag.Diagnostics{}
+
+		s.verifyLookup(context.TODO(), plan, state, &diags)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Empty(t, api.offsets)
+	})
+
+	t.Run("does not look up the server if the lookup is unchanged", func(t *testing.T) {
+		s, api := newServerResource()
+		adopted := state
+		adopted.AssetID = types.StringValue("627293")
+		diags := diag.Diagnostics{}
+
+		s.verifyLookup(context.TODO(), adopted, adopted, &diags)
+
+		assert.False(t, diags.HasError())
+		assert.Empty(t, api.offsets)
+	})
+
+	t.Run("does not look up the server without a lookup", func(t *testing.T) {
+		s, api := newServerResource()
+		diags := diag.Diagnostics{}
+
+		s.verifyLookup(context.TODO(), state, state, &diags)
+
+		assert.False(t, diags.HasError())
+		assert.Empty(t, api.offsets)
+	})
+}
@@ -2,10 +2,17 @@ package dedicatedserver
 
 import (
 	"context"
+	"net/http"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
@@ -19,14 +26,61 @@ type serversDataSource struct {
 }
 
 type serversDataSourceModel struct {
-	IDs                   []types.String `tfsdk:"ids"`
-	Reference             types.String   `tfsdk:"reference"`
-	IP                    types.String   `tfsdk:"ip"`
-	MacAddress            types.String   `tfsdk:"mac_address"`
-	Site                  types.String   `tfsdk:"site"`
-	PrivateRackID         types.String   `tfsdk:"private_rack_id"`
-	PrivateNetworkCapable types.String   `tfsdk:"private_network_capable"`
-	PrivateNetworkEnabled types.String   `tfsdk:"private_network_enabled"`
+	IDs                   []types.String          `tfsdk:"ids"`
+	Servers               []serverDataSourceModel `tfsdk:"servers"`
+	Reference             types.String            `tfsdk:"reference"`
+	IP                    types.String            `tfsdk:"ip"`
+	MacAddress            types.String            `tfsdk:"mac_address"`
+	Site                  types.String            `tfsdk:"site"`
+	PrivateRackID         types.String            `tfsdk:"private_rack_id"`
+	PrivateNetworkCapable types.String            `tfsdk:"private_network_capable"`
+	PrivateNetworkEnabled types.String            `tfsdk:"private_network_enabled"`
+	ReferenceRegex        types.String            `tfsdk:"reference_regex"`
+	RackType              types.String            `tfsdk:"rack_type"`
+	ContractStatus        types.String            `tfsdk:"contract_status"`
+	IncludePowerState     types.Bool              `tfsdk:"include_power_state"`
+}
+
+// serverFilter filters servers on attributes the API cannot filter on.
+type serverFilter struct {
+	referenceRegex *regexp.Regexp
+	rackType       *string
+	contractStatus *string
+}
+
+func (f serverFilter) matches(server dedicatedserver.Server) bool {
+	contract := server.GetContract()
+	rack := server.GetRack()
+
+	if f.referenceRegex != nil && !f.referenceRegex.MatchString(contract.GetReference()) {
+		return false
+	}
+
+	if f.rackType != nil && string(rack.GetType()) != *f.rackType {
+		return false
+	}
+
+	if f.contractStatus != nil && !strings.EqualFold(contract.GetStatus(), *f.contractStatus) {
+		return false
+	}
+
+	return true
+}
+
+// getAllServers retrieves every page of the server list.
+func getAllServers(
+	request dedicatedserver.ApiGetServerListRequest,
+) ([]dedicatedserver.Server, *http.Response, error) {
+	return utils.GetAllPages(
+		func(offset int32) (*utils.Page[dedicatedserver.Server], *http.Response, error) {
+			result, response, err := request.Limit(pageLimit).Offset(offset).Execute()
+			if err != nil {
+				return nil, response, err
+			}
+
+			return newPage(result.GetServers(), result.GetMetadata()), nil, nil
+		},
+	)
 }
 
 func (s *serversDataSource) Read(
@@ -36,8 +90,28 @@ func (s *serversDataSource) Read(
 ) {
 	var config serversDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
-	// NOTE: we show only the latest 50 items.
-	request := s.DedicatedserverAPI.GetServerList(ctx).Limit(50)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := serverFilter{
+		rackType:       utils.AdaptStringPointerValueToNullableString(config.RackType),
+		contractStatus: utils.AdaptStringPointerValueToNullableString(config.ContractStatus),
+	}
+	if !config.ReferenceRegex.IsNull() && !config.ReferenceRegex.IsUnknown() {
+		referenceRegex, err := regexp.Compile(config.ReferenceRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reference_regex"),
+				"Invalid regular expression",
+				err.Error(),
+			)
+			return
+		}
+		filter.referenceRegex = referenceRegex
+	}
+
+	request := s.DedicatedserverAPI.GetServerList(ctx)
 
 	if !config.Reference.IsNull() && !config.Reference.IsUnknown() {
 		request = request.Reference(config.Reference.ValueString())
@@ -68,14 +142,30 @@ func (s *serversDataSource) Read(
 	}
 
 	var Ids []types.String
+	servers := []serverDataSourceModel{}
 
-	result, response, err := request.Execute()
+	result, response, err := getAllServers(request)
 	if err != nil {
 		utils.SdkError(ctx, &resp.Diagnostics, err, response)
 		return
 	}
-	for _, server := range result.GetServers() {
+	for _, server := range result {
+		if !filter.matches(server) {
+			continue
+		}
+
 		Ids = append(Ids, types.StringValue(server.GetId()))
+
+		serverModel := adaptServerToServerDataSource(server)
+		if config.IncludePowerState.ValueBool() {
+			poweredOn, response, err := getPoweredOn(ctx, s.DedicatedserverAPI, server.GetId())
+			if err != nil {
+				utils.SdkError(ctx, &resp.Diagnostics, err, response)
+				return
+			}
+			serverModel.PoweredOn = types.BoolValue(poweredOn)
+		}
+		servers = append(servers, serverModel)
 	}
 
 	resp.Diagnostics.Append(
@@ -83,6 +173,7 @@ func (s *serversDataSource) Read(
 			ctx,
 			serversDataSourceModel{
 				IDs:                   Ids,
+				Servers:               servers,
 				Reference:             config.Reference,
 				IP:                    config.IP,
 				MacAddress:            config.MacAddress,
@@ -90,6 +181,10 @@ func (s *serversDataSource) Read(
 				PrivateRackID:         config.PrivateRackID,
 				PrivateNetworkCapable: config.PrivateNetworkCapable,
 				PrivateNetworkEnabled: config.PrivateNetworkEnabled,
+				ReferenceRegex:        config.ReferenceRegex,
+				RackType:              config.RackType,
+				ContractStatus:        config.ContractStatus,
+				IncludePowerState:     config.IncludePowerState,
 			},
 		)...,
 	)
@@ -107,6 +202,13 @@ func (s *serversDataSource) Schema(
 				Computed:    true,
 				Description: "List of the dedicated server IDs available to the account.",
 			},
+			"servers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of the dedicated servers available to the account.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverDataSourceAttributes(),
+				},
+			},
 			"reference": schema.StringAttribute{
 				Optional:    true,
 				Description: "Filter the list of servers by reference.",
@@ -135,6 +237,29 @@ func (s *serversDataSource) Schema(
 				Optional:    true,
 				Description: "Filter the list for private network enabled servers.",
 			},
+			"reference_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of servers by a regular expression matching the reference. The filter is applied client-side.",
+				Validators: []validator.String{
+					validRegex(),
+				},
+			},
+			"rack_type": schema.StringAttribute{
+				Optional: true,
+				Description: "Filter the list of servers by rack type. The filter is applied client-side. Valid options are " +
+					utils.StringTypeArrayToMarkdown(dedicatedserver.AllowedRackTypeEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(dedicatedserver.AllowedRackTypeEnumValues)...),
+				},
+			},
+			"contract_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of servers by contract status, i.e. *ACTIVE*. The filter is applied client-side.",
+			},
+			"include_power_state": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Retrieve the power state of every server. This requires an additional API call per server.",
+			},
 		},
 	}
 }
@@ -0,0 +1,123 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_serverFilter_matches(t *testing.T) {
+	reference := "web.server"
+	status := "ACTIVE"
+	rackType := dedicatedserver.RACKTYPE_SHARED
+
+	server := dedicatedserver.Server{
+		Contract: &dedicatedserver.Contract{
+			Reference: *dedicatedserver.NewNullableString(&reference),
+			Status:    &status,
+		},
+		Rack: &dedicatedserver.Rack{Type: &rackType},
+	}
+
+	t.Run("matches without filters", func(t *testing.T) {
+		assert.True(t, serverFilter{}.matches(server))
+	})
+
+	t.Run("matches on reference regex", func(t *testing.T) {
+		assert.True(t, serverFilter{referenceRegex: regexp.MustCompile(`^web\.`)}.matches(server))
+		assert.False(t, serverFilter{referenceRegex: regexp.MustCompile(`^database\.`)}.matches(server))
+	})
+
+	t.Run("matches on rack type", func(t *testing.T) {
+		shared := "SHARED"
+		dedicated := "DEDICATED"
+
+		assert.True(t, serverFilter{rackType: &shared}.matches(server))
+		assert.False(t, serverFilter{rackType: &dedicated}.matches(server))
+	})
+
+	t.Run("matches on contract status regardless of case", func(t *testing.T) {
+		active := "active"
+		suspended := "SUSPENDED"
+
+		assert.True(t, serverFilter{contractStatus: &active}.matches(server))
+		assert.False(t, serverFilter{contractStatus: &suspended}.matches(server))
+	})
+
+	t.Run("does not match servers without contract on contract filters", func(t *testing.T) {
+		assert.False(t, serverFilter{referenceRegex: regexp.MustCompile(`.+`)}.matches(dedicatedserver.Server{}))
+	})
+}
+
+// serverListAPI fakes the server list endpoint of the dedicated server API,
+// returning one page per call.
+type serverListAPI struct {
+	dedicatedserver.DedicatedserverAPI
+	pages      [][]dedicatedserver.Server
+	totalCount int32
+	offsets    []int32
+	err        error
+}
+
+func (s *serverListAPI) GetServerList(_ context.Context) dedicatedserver.ApiGetServerListRequest {
+	return dedicatedserver.ApiGetServerListRequest{ApiService: s}
+}
+
+func (s *serverListAPI) GetServerListExecute(
+	_ dedicatedserver.ApiGetServerListRequest,
+) (*dedicatedserver.GetServerListResult, *http.Response, error) {
+	if s.err != nil {
+		return nil, &http.Response{StatusCode: http.StatusInternalServerError}, s.err
+	}
+
+	page := len(s.offsets)
+	offset := int32(page) * pageLimit
+	s.offsets = append(s.offsets, offset)
+
+	return &dedicatedserver.GetServerListResult{
+		Servers: s.pages[page],
+		Metadata: &dedicatedserver.Metadata{
+			Limit:      pageLimit,
+			Offset:     offset,
+			TotalCount: s.totalCount,
+		},
+	}, nil, nil
+}
+
+func Test_getAllServers(t *testing.T) {
+	newServers := func(count int) []dedicatedserver.Server {
+		servers := make([]dedicatedserver.Server, count)
+		for i := range servers {
+			servers[i] = dedicatedserver.Server{Id: dedicatedserver.PtrString("server")}
+		}
+		return servers
+	}
+
+	t.Run("retrieves every page", func(t *testing.T) {
+		api := &serverListAPI{
+			pages:      [][]dedicatedserver.Server{newServers(50), newServers(50), newServers(20)},
+			totalCount: 120,
+		}
+
+		servers, _, err := getAllServers(dedicatedserver.ApiGetServerListRequest{ApiService: api})
+
+		assert.NoError(t, err)
+		assert.Len(t, servers, 120)
+		assert.Equal(t, []int32{0, 50, 100}, api.offsets)
+	})
+
+	t.Run("returns the API error", func(t *testing.T) {
+		api := &serverListAPI{err: errors.New("internal server error")}
+
+		servers, response, err := getAllServers(dedicatedserver.ApiGetServerListRequest{ApiService: api})
+
+		assert.Nil(t, servers)
+		assert.EqualError(t, err, "internal server error")
+		assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+	})
+}
@@ -0,0 +1,183 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource              = &triggeredJobResource{}
+	_ resource.ResourceWithConfigure = &triggeredJobResource{}
+)
+
+// triggeredJobRequest contains the options of a request that starts a job.
+type triggeredJobRequest struct {
+	serverID    string
+	callbackURL *string
+	powerCycle  *bool
+}
+
+// triggeredJobResource is shared by the hardware scan and IPMI reset
+// resources, as both start a job on the server, wait for it to finish and
+// start a new job when their triggers change.
+type triggeredJobResource struct {
+	utils.ResourceAPI
+	description string
+	// jobName completes the attribute descriptions, i.e.: "hardware scan".
+	jobName               string
+	powerCycleDescription string
+	startJob              func(
+		ctx context.Context,
+		api dedicatedserver.DedicatedserverAPI,
+		request triggeredJobRequest,
+	) (*dedicatedserver.Job, *http.Response, error)
+}
+
+type triggeredJobResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	CallbackURL       types.String `tfsdk:"callback_url"`
+	PowerCycle        types.Bool   `tfsdk:"power_cycle"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+}
+
+func (t *triggeredJobResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: t.description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("Unique identifier of the %s job", t.jobName),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callback_url": schema.StringAttribute{
+				Description: fmt.Sprintf("Url which will receive callbacks when the %s is finished or failed", t.jobName),
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"power_cycle": schema.BoolAttribute{
+				Description: t.powerCycleDescription + " Defaults to `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: fmt.Sprintf("Arbitrary map of values that, when changed, will trigger a new %s", t.jobName),
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (t *triggeredJobResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan triggeredJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.DedicatedServerID.ValueString()
+	job, response, err := t.startJob(ctx, t.DedicatedserverAPI, triggeredJobRequest{
+		serverID:    serverID,
+		callbackURL: utils.AdaptStringPointerValueToNullableString(plan.CallbackURL),
+		powerCycle:  utils.AdaptBoolPointerValueToNullableBool(plan.PowerCycle),
+	})
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	plan.ID = types.StringValue(job.GetUuid())
+
+	_, response, err = waitForJobAndRetrieveUntilFinished(ctx, t.DedicatedserverAPI, serverID, job.GetUuid())
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (t *triggeredJobResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state triggeredJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, response, err := t.DedicatedserverAPI.GetJob(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+		state.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		// The job has been purged, so a new one has to be started.
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (t *triggeredJobResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+// Delete only removes the job from the state, as a finished job cannot be
+// undone.
+func (t *triggeredJobResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
@@ -0,0 +1,184 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// triggeredJobAPI fakes the endpoints of the dedicated server API that start
+// a hardware scan or an IPMI reset, next to the job endpoints.
+type triggeredJobAPI struct {
+	jobAPI
+	startedJobs []string
+	startErr    error
+}
+
+func (t *triggeredJobAPI) ScanHardware(_ context.Context, _ string) dedicatedserver.ApiScanHardwareRequest {
+	return dedicatedserver.ApiScanHardwareRequest{ApiService: t}
+}
+
+func (t *triggeredJobAPI) ScanHardwareExecute(_ dedicatedserver.ApiScanHardwareRequest) (*dedicatedserver.Job, *http.Response, error) {
+	return t.startJob("hardwareScan")
+}
+
+func (t *triggeredJobAPI) IpmiReset(_ context.Context, _ string) dedicatedserver.ApiIpmiResetRequest {
+	return dedicatedserver.ApiIpmiResetRequest{ApiService: t}
+}
+
+func (t *triggeredJobAPI) IpmiResetExecute(_ dedicatedserver.ApiIpmiResetRequest) (*dedicatedserver.Job, *http.Response, error) {
+	return t.startJob("ipmiReset")
+}
+
+func (t *triggeredJobAPI) startJob(jobType string) (*dedicatedserver.Job, *http.Response, error) {
+	t.startedJobs = append(t.startedJobs, jobType)
+	if t.startErr != nil {
+		return nil, &http.Response{StatusCode: http.StatusConflict, Body: http.NoBody}, t.startErr
+	}
+
+	return &dedicatedserver.Job{Uuid: dedicatedserver.PtrString("jobId")}, nil, nil
+}
+
+func newTriggeredJobResource(
+	newResource func() resource.Resource,
+	api dedicatedserver.DedicatedserverAPI,
+) *triggeredJobResource {
+	r := newResource().(*triggeredJobResource)
+	r.DedicatedserverAPI = api
+
+	return r
+}
+
+// newTriggeredJobValue returns the object value of the resource with the
+// given ID.
+func newTriggeredJobValue(
+	t *testing.T,
+	r resource.Resource,
+	id tftypes.Value,
+) (schemaResponse resource.SchemaResponse, value tftypes.Value) {
+	t.Helper()
+
+	r.Schema(context.TODO(), resource.SchemaRequest{}, &schemaResponse)
+	objectType := schemaResponse.Schema.Type().TerraformType(context.TODO())
+
+	return schemaResponse, tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                  id,
+		"dedicated_server_id": tftypes.NewValue(tftypes.String, "12345"),
+		"callback_url":        tftypes.NewValue(tftypes.String, nil),
+		"power_cycle":         tftypes.NewValue(tftypes.Bool, true),
+		"triggers":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+	})
+}
+
+func newTriggeredJobCreateRequest(t *testing.T, r resource.Resource) (resource.CreateRequest, resource.CreateResponse) {
+	t.Helper()
+
+	schemaResponse, plan := newTriggeredJobValue(t, r, tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+	objectType := schemaResponse.Schema.Type().TerraformType(context.TODO())
+
+	return resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResponse.Schema, Raw: plan},
+	}, resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+}
+
+func newTriggeredJobState(t *testing.T, r resource.Resource) tfsdk.State {
+	t.Helper()
+
+	schemaResponse, state := newTriggeredJobValue(t, r, tftypes.NewValue(tftypes.String, "jobId"))
+
+	return tfsdk.State{Schema: schemaResponse.Schema, Raw: state}
+}
+
+func Test_triggeredJobResource_Create(t *testing.T) {
+	resources := map[string]func() resource.Resource{
+		"hardwareScan": NewHardwareScanResource,
+		"ipmiReset":    NewIPMIResetResource,
+	}
+
+	for jobType, newResource := range resources {
+		t.Run(jobType+" stores the ID of the finished job", func(t *testing.T) {
+			api := &triggeredJobAPI{}
+			r := newTriggeredJobResource(newResource, api)
+			req, resp := newTriggeredJobCreateRequest(t, r)
+
+			r.Create(context.TODO(), req, &resp)
+
+			var state triggeredJobResourceModel
+			resp.State.Get(context.TODO(), &state)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, "jobId", state.ID.ValueString())
+			assert.Equal(t, []string{jobType}, api.startedJobs)
+			assert.Equal(t, 1, api.calls)
+		})
+
+		t.Run(jobType+" does not wait for a job if starting it fails", func(t *testing.T) {
+			api := &triggeredJobAPI{startErr: errors.New("conflict")}
+			r := newTriggeredJobResource(newResource, api)
+			req, resp := newTriggeredJobCreateRequest(t, r)
+
+			r.Create(context.TODO(), req, &resp)
+
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.True(t, resp.State.Raw.IsNull())
+			assert.Equal(t, 0, api.calls)
+		})
+
+		t.Run(jobType+" does not store the job if waiting for it fails", func(t *testing.T) {
+			api := &triggeredJobAPI{jobAPI: jobAPI{err: errors.New("internal server error")}}
+			r := newTriggeredJobResource(newResource, api)
+			req, resp := newTriggeredJobCreateRequest(t, r)
+
+			r.Create(context.TODO(), req, &resp)
+
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+}
+
+func Test_triggeredJobResource_Read(t *testing.T) {
+	t.Run("removes the resource if the job has been purged", func(t *testing.T) {
+		api := &triggeredJobAPI{jobAPI: jobAPI{err: errors.New("not found"), errStatusCode: http.StatusNotFound}}
+		r := newTriggeredJobResource(NewIPMIResetResource, api)
+		state := newTriggeredJobState(t, r)
+		resp := resource.ReadResponse{State: state}
+
+		r.Read(context.TODO(), resource.ReadRequest{State: state}, &resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.True(t, resp.State.Raw.IsNull())
+	})
+
+	t.Run("sets an error if the job cannot be retrieved", func(t *testing.T) {
+		api := &triggeredJobAPI{jobAPI: jobAPI{err: errors.New("internal server error")}}
+		r := newTriggeredJobResource(NewIPMIResetResource, api)
+		state := newTriggeredJobState(t, r)
+		resp := resource.ReadResponse{State: state}
+
+		r.Read(context.TODO(), resource.ReadRequest{State: state}, &resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("keeps the resource if the job exists", func(t *testing.T) {
+		api := &triggeredJobAPI{}
+		r := newTriggeredJobResource(NewHardwareScanResource, api)
+		state := newTriggeredJobState(t, r)
+		resp := resource.ReadResponse{State: state}
+
+		r.Read(context.TODO(), resource.ReadRequest{State: state}, &resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.False(t, resp.State.Raw.IsNull())
+	})
+}
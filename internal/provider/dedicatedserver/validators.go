@@ -3,6 +3,7 @@ package dedicatedserver
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -54,3 +55,40 @@ func (v greaterThanZeroValidator) MarkdownDescription(ctx context.Context) strin
 func greaterThanZero() validator.String {
 	return greaterThanZeroValidator{}
 }
+
+// validRegexValidator ensures that the given value is a valid regular
+// expression.
+type validRegexValidator struct{}
+
+func (v validRegexValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Regular Expression",
+			err.Error(),
+		)
+	}
+}
+
+var _ validator.String = validRegexValidator{}
+
+func (v validRegexValidator) Description(_ context.Context) string {
+	return "Ensures that the value is a valid regular expression"
+}
+
+func (v validRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// validRegex returns a new instance of the validator.
+func validRegex() validator.String {
+	return validRegexValidator{}
+}
@@ -88,3 +88,31 @@ func Test_greaterThanZeroValidator_ValidateString(t *testing.T) {
 		assert.Len(t, response.Diagnostics.Errors(), 1)
 	})
 }
+
+func Test_validRegexValidator_ValidateString(t *testing.T) {
+	t.Run("does not set errors if the value is a valid regular expression", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue(`^web\..*$`),
+		}
+
+		response := validator.StringResponse{}
+
+		validRegexValidator := validRegex()
+		validRegexValidator.ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("set errors if the value is not a valid regular expression", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue(`web(`),
+		}
+
+		response := validator.StringResponse{}
+
+		validRegexValidator := validRegex()
+		validRegexValidator.ValidateString(context.TODO(), request, &response)
+
+		assert.Len(t, response.Diagnostics.Errors(), 1)
+	})
+}
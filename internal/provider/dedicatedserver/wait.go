@@ -0,0 +1,65 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+)
+
+// errWaitTimedOut is returned by waitUntil when check is not done after the
+// maximum number of tries.
+var errWaitTimedOut = errors.New("timed out")
+
+// waitUntil calls check every interval until it is done. Waiting stops at
+// the first error of check, when ctx is canceled or after maxTries. The
+// description completes "waiting for" in the returned errors. The result of
+// the last check is returned on a timeout, so that callers can report it.
+func waitUntil[T any](
+	ctx context.Context,
+	interval time.Duration,
+	maxTries int,
+	description string,
+	check func() (result T, done bool, response *http.Response, err error),
+) (T, *http.Response, error) {
+	bo := backoff.NewConstantBackOff(interval)
+
+	for try := 1; ; try++ {
+		result, done, response, err := check()
+		if err != nil {
+			// An interrupt mostly arrives while a request is in flight.
+			if ctx.Err() != nil {
+				return result, nil, interruptedError(ctx, description)
+			}
+
+			return result, response, err
+		}
+
+		if done {
+			return result, response, nil
+		}
+
+		if try >= maxTries {
+			return result, nil, fmt.Errorf(
+				"%w waiting for %s after %.0f minutes",
+				errWaitTimedOut,
+				description,
+				(interval * time.Duration(maxTries)).Minutes(),
+			)
+		}
+
+		// Sleep for the backoff interval before retrying
+		select {
+		case <-ctx.Done():
+			return result, nil, interruptedError(ctx, description)
+		case <-time.After(bo.NextBackOff()):
+		}
+	}
+}
+
+func interruptedError(ctx context.Context, description string) error {
+	return fmt.Errorf("interrupted while waiting for %s: %w", description, ctx.Err())
+}
@@ -0,0 +1,110 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_waitUntil(t *testing.T) {
+	t.Run("returns the result once check is done", func(t *testing.T) {
+		tries := 0
+
+		got, _, err := waitUntil(
+			context.TODO(),
+			time.Millisecond,
+			5,
+			"check to be done",
+			func() (int, bool, *http.Response, error) {
+				tries++
+				return tries, tries == 3, nil, nil
+			},
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, got)
+	})
+
+	t.Run("times out after the maximum number of tries", func(t *testing.T) {
+		tries := 0
+
+		got, _, err := waitUntil(
+			context.TODO(),
+			time.Millisecond,
+			3,
+			"check to be done",
+			func() (int, bool, *http.Response, error) {
+				tries++
+				return tries, false, nil, nil
+			},
+		)
+
+		assert.ErrorIs(t, err, errWaitTimedOut)
+		assert.ErrorContains(t, err, "timed out waiting for check to be done after")
+		assert.Equal(t, 3, got)
+	})
+
+	t.Run("stops at the first error of check", func(t *testing.T) {
+		tries := 0
+
+		_, response, err := waitUntil(
+			context.TODO(),
+			time.Millisecond,
+			5,
+			"check to be done",
+			func() (int, bool, *http.Response, error) {
+				tries++
+				return 0, false, &http.Response{StatusCode: http.StatusInternalServerError}, errors.New("internal server error")
+			},
+		)
+
+		assert.EqualError(t, err, "internal server error")
+		assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+		assert.Equal(t, 1, tries)
+	})
+
+	t.Run("is interrupted when ctx is canceled during check", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		_, response, err := waitUntil(
+			ctx,
+			time.Millisecond,
+			5,
+			"check to be done",
+			func() (int, bool, *http.Response, error) {
+				cancel()
+				return 0, false, nil, context.Canceled
+			},
+		)
+
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.EqualError(t, err, "interrupted while waiting for check to be done: context canceled")
+	})
+
+	t.Run("is interrupted when ctx is canceled while sleeping", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		tries := 0
+
+		_, _, err := waitUntil(
+			ctx,
+			time.Hour,
+			5,
+			"check to be done",
+			func() (int, bool, *http.Response, error) {
+				tries++
+				cancel()
+				return 0, false, nil, nil
+			},
+		)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, tries)
+	})
+}
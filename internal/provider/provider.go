@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,7 +20,8 @@ import (
 )
 
 var (
-	_ provider.Provider = &leasewebProvider{}
+	_ provider.Provider              = &leasewebProvider{}
+	_ provider.ProviderWithFunctions = &leasewebProvider{}
 )
 
 func New(version string) func() provider.Provider {
@@ -164,7 +166,16 @@ func (p *leasewebProvider) DataSources(_ context.Context) []func() datasource.Da
 		dedicatedserver.NewServersDataSource,
 		dedicatedserver.NewControlPanelsDataSource,
 		dedicatedserver.NewOperatingSystemsDataSource,
+		dedicatedserver.NewOperatingSystemDataSource,
 		dedicatedserver.NewCredentialDataSource,
+		dedicatedserver.NewCredentialsDataSource,
+		dedicatedserver.NewJobDataSource,
+		dedicatedserver.NewRescueImagesDataSource,
+		dedicatedserver.NewHardwareDataSource,
+		dedicatedserver.NewBandwidthMetricsDataSource,
+		dedicatedserver.NewDatatrafficMetricsDataSource,
+		dedicatedserver.NewNotificationSettingsDataSource,
+		dedicatedserver.NewIPsDataSource,
 		publiccloud.NewImagesDataSource,
 		publiccloud.NewLoadBalancersDataSource,
 		publiccloud.NewLoadBalancerListenersDataSource,
@@ -185,6 +196,13 @@ func (p *leasewebProvider) Resources(_ context.Context) []func() resource.Resour
 		dedicatedserver.NewNotificationSettingDatatrafficResource,
 		dedicatedserver.NewNotificationSettingBandwidthResource,
 		dedicatedserver.NewInstallationResource,
+		dedicatedserver.NewRescueModeResource,
+		dedicatedserver.NewHardwareScanResource,
+		dedicatedserver.NewIPMIResetResource,
+		dedicatedserver.NewNetworkInterfaceResource,
+		dedicatedserver.NewDhcpReservationResource,
+		dedicatedserver.NewPrivateNetworkResource,
+		dedicatedserver.NewIPResource,
 		publiccloud.NewImageResource,
 		publiccloud.NewLoadBalancerResource,
 		publiccloud.NewLoadBalancerListenerResource,
@@ -196,3 +214,9 @@ func (p *leasewebProvider) Resources(_ context.Context) []func() resource.Resour
 		ipmgmt.NewNullRouteResource,
 	}
 }
+
+func (p *leasewebProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		dedicatedserver.NewPartitionLayoutFunction,
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -341,6 +342,75 @@ func TestAccPublicCloudInstanceResource(t *testing.T) {
 		})
 	})
 
+	t.Run("keeps an instance in the desired state", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					resource "leaseweb_public_cloud_instance" "test" {
+					  region = "eu-west-3"
+					  type = "lsw.m3.large"
+					  contract = {
+					    billing_frequency = 1
+					    term = 0
+					    type = "HOURLY"
+					  }
+					  image = {
+					    id = "UBUNTU_20_04_64BIT"
+					  }
+					  root_disk_storage_type = "CENTRAL"
+					  root_disk_size         = 5
+					  desired_state          = "RUNNING"
+					}
+					`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_public_cloud_instance.test",
+							"desired_state",
+							"RUNNING",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_public_cloud_instance.test",
+							"state",
+							"RUNNING",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("an invalid desired_state throws an error", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					resource "leaseweb_public_cloud_instance" "test" {
+					  region = "eu-west-3"
+					  type = "lsw.m3.large"
+					  contract = {
+					    billing_frequency = 1
+					    term = 0
+					    type = "HOURLY"
+					  }
+					  image = {
+					    id = "UBUNTU_20_04_64BIT"
+					  }
+					  root_disk_storage_type = "CENTRAL"
+					  root_disk_size         = 5
+					  desired_state          = "STOPPING"
+					}
+					`,
+					ExpectError: regexp.MustCompile(
+						`Attribute desired_state value must be one of:`,
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("an invalid region throws an error", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -426,6 +496,65 @@ func TestAccPublicCloudInstanceResource(t *testing.T) {
 		})
 	})
 
+	t.Run("updating image.id with reinstall_on_image_change reinstalls in place", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					resource "leaseweb_public_cloud_instance" "test" {
+					  region = "eu-west-3"
+					  type = "lsw.m3.large"
+					  contract = {
+					    billing_frequency = 1
+					    term = 0
+					    type = "HOURLY"
+					  }
+					  image = {
+					    id = "UBUNTU_20_04_64BIT"
+					  }
+					  root_disk_storage_type    = "CENTRAL"
+					  root_disk_size            = 5
+					  reinstall_on_image_change = true
+					}
+					`,
+				},
+				{
+					// Prism always returns the instance as RUNNING with the old
+					// image, so the reinstall would never be seen to start. Only
+					// check the plan, the reinstall is covered by unit tests.
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PostApplyPreRefresh: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(
+								"leaseweb_public_cloud_instance.test",
+								plancheck.ResourceActionUpdate,
+							),
+						},
+					},
+					Config: providerConfig + `
+					resource "leaseweb_public_cloud_instance" "test" {
+					  region = "eu-west-3"
+					  type = "lsw.m3.large"
+					  contract = {
+					    billing_frequency = 1
+					    term = 0
+					    type = "HOURLY"
+					  }
+					  image = {
+					    id = "UBUNTU_24_04_64BIT"
+					  }
+					  root_disk_storage_type    = "CENTRAL"
+					  root_disk_size            = 5
+					  reinstall_on_image_change = true
+					}
+					`,
+				},
+			},
+		})
+	})
+
 	t.Run("an invalid type throws an error", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -1111,6 +1240,13 @@ func TestAccDedicatedServerNotificationSettingBandwidthResource(t *testing.T) {
 						),
 					),
 				},
+				// ImportState testing
+				{
+					ResourceName:      "leaseweb_dedicated_server_notification_setting_bandwidth.test",
+					ImportStateId:     "12345678,12345",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -1295,6 +1431,60 @@ func TestAccDedicatedServerCredentialDataSource(t *testing.T) {
 	})
 }
 
+func TestAccDedicatedServerCredentialsDataSource(t *testing.T) {
+	t.Run("lists the credentials of a dedicated server without passwords", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_credentials" "test" {
+						  dedicated_server_id = "12345"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_credentials.test",
+							"credentials.0.type",
+							"REMOTE_MANAGEMENT",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_credentials.test",
+							"credentials.0.username",
+							"admin",
+						),
+						resource.TestCheckNoResourceAttr(
+							"data.leaseweb_dedicated_server_credentials.test",
+							"credentials.0.password",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("includes the passwords when asked to", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_credentials" "test" {
+						  dedicated_server_id = "12345"
+						  include_passwords   = true
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_credentials.test",
+							"credentials.0.password",
+							"mys3cr3tp@ssw0rd",
+						),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccDedicatedServerCredentialResource(t *testing.T) {
 	t.Run("creates and updates a credential", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
@@ -1325,6 +1515,83 @@ func TestAccDedicatedServerCredentialResource(t *testing.T) {
 		})
 	})
 
+	t.Run("generates and rotates a password", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					resource "leaseweb_dedicated_server_credential" "test" {
+						dedicated_server_id = "12345"
+						username = "root"
+						type = "OPERATING_SYSTEM"
+						password_policy = {
+							length  = 24
+							special = false
+						}
+						keepers = {
+							rotation = "1"
+						}
+					}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet(
+							"leaseweb_dedicated_server_credential.test",
+							"password",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_credential.test",
+							"password_policy.length",
+							"24",
+						),
+					),
+				},
+				{
+					Config: providerConfig + `
+					resource "leaseweb_dedicated_server_credential" "test" {
+						dedicated_server_id = "12345"
+						username = "root"
+						type = "OPERATING_SYSTEM"
+						password_policy = {
+							length  = 24
+							special = false
+						}
+						keepers = {
+							rotation = "2"
+						}
+					}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_credential.test",
+							"keepers.rotation",
+							"2",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("password_policy conflicts with password", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_credential" "test" {
+							dedicated_server_id = "12345"
+							username = "root"
+							type = "OPERATING_SYSTEM"
+							password = "mys3cr3tp@ssw0rd"
+							password_policy = {
+								length = 24
+							}
+						}`,
+					ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+				},
+			},
+		})
+	})
+
 	t.Run("type must be valid", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -1371,6 +1638,13 @@ func TestAccDataTrafficNotificationSettingResource(t *testing.T) {
 					  unit = "GB"
 					}`,
 				},
+				// ImportState testing
+				{
+					ResourceName:      "leaseweb_dedicated_server_notification_setting_datatraffic.test",
+					ImportStateId:     "145406,12345",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
 				// Delete testing automatically occurs in TestCase
 			},
 		})
@@ -1522,48 +1796,60 @@ func TestAccDedicatedServerInstallationResource(t *testing.T) {
 			})
 		})
 
-	t.Run(
-		"server id should be in the request",
+	t.Run("install os on a dedicated server without waiting for completion",
 		func(t *testing.T) {
 			resource.Test(t, resource.TestCase{
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
 					{
 						Config: providerConfig + `
-						    resource "leaseweb_dedicated_server_installation" "test" {
-						      operating_system_id = "UBUNTU_22_04_64BIT"
-						    }`,
-						ExpectError: regexp.MustCompile(
-							"The argument \"dedicated_server_id\" is required, but no definition was found",
+						resource "leaseweb_dedicated_server_installation" "test" {
+							dedicated_server_id = "12345"
+						    operating_system_id = "UBUNTU_22_04_64BIT"
+						    wait_for_completion = false
+						}`,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttrSet(
+								"leaseweb_dedicated_server_installation.test",
+								"id",
+							),
+							resource.TestCheckResourceAttr(
+								"leaseweb_dedicated_server_installation.test",
+								"wait_for_completion",
+								"false",
+							),
 						),
 					},
 				},
 			})
-		},
-	)
+		})
 
-	t.Run(
-		"operating system id should be in the request",
+	t.Run("install os on a dedicated server and cancel it on destroy",
 		func(t *testing.T) {
 			resource.Test(t, resource.TestCase{
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
 					{
 						Config: providerConfig + `
-						    resource "leaseweb_dedicated_server_installation" "test" {
-						      dedicated_server_id = "12345"
-						    }`,
-						ExpectError: regexp.MustCompile(
-							"The argument \"operating_system_id\" is required, but no definition was found",
+						resource "leaseweb_dedicated_server_installation" "test" {
+							dedicated_server_id = "12345"
+						    operating_system_id = "UBUNTU_22_04_64BIT"
+						    wait_for_completion = false
+						    cancel_on_destroy   = true
+						}`,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"leaseweb_dedicated_server_installation.test",
+								"cancel_on_destroy",
+								"true",
+							),
 						),
 					},
 				},
 			})
-		},
-	)
+		})
 
-	t.Run(
-		"raid.level should be one of these values '0', '1', '5', '10'",
+	t.Run("changing triggers reinstalls the dedicated server",
 		func(t *testing.T) {
 			resource.Test(t, resource.TestCase{
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -1573,12 +1859,95 @@ func TestAccDedicatedServerInstallationResource(t *testing.T) {
 						resource "leaseweb_dedicated_server_installation" "test" {
 							dedicated_server_id = "12345"
 						    operating_system_id = "UBUNTU_22_04_64BIT"
-						    raid = {
-						    	level = 11
+						    triggers = {
+						      image_version = "1"
 						    }
 						}`,
-						ExpectError: regexp.MustCompile(
-							`Attribute raid.level value must be one of: \["0" "1" "5" "10"]`,
+						Check: resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_installation.test",
+							"triggers.image_version",
+							"1",
+						),
+					},
+					{
+						Config: providerConfig + `
+						resource "leaseweb_dedicated_server_installation" "test" {
+							dedicated_server_id = "12345"
+						    operating_system_id = "UBUNTU_22_04_64BIT"
+						    triggers = {
+						      image_version = "2"
+						    }
+						}`,
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectResourceAction(
+									"leaseweb_dedicated_server_installation.test",
+									plancheck.ResourceActionReplace,
+								),
+							},
+						},
+					},
+				},
+			})
+		})
+
+	t.Run(
+		"server id should be in the request",
+		func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+						    resource "leaseweb_dedicated_server_installation" "test" {
+						      operating_system_id = "UBUNTU_22_04_64BIT"
+						    }`,
+						ExpectError: regexp.MustCompile(
+							"The argument \"dedicated_server_id\" is required, but no definition was found",
+						),
+					},
+				},
+			})
+		},
+	)
+
+	t.Run(
+		"operating system id should be in the request",
+		func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+						    resource "leaseweb_dedicated_server_installation" "test" {
+						      dedicated_server_id = "12345"
+						    }`,
+						ExpectError: regexp.MustCompile(
+							"The argument \"operating_system_id\" is required, but no definition was found",
+						),
+					},
+				},
+			})
+		},
+	)
+
+	t.Run(
+		"raid.level should be one of these values '0', '1', '5', '10'",
+		func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+						resource "leaseweb_dedicated_server_installation" "test" {
+							dedicated_server_id = "12345"
+						    operating_system_id = "UBUNTU_22_04_64BIT"
+						    raid = {
+						    	level = 11
+						    }
+						}`,
+						ExpectError: regexp.MustCompile(
+							`Attribute raid.level value must be one of: \["0" "1" "5" "10"]`,
 						),
 					},
 				},
@@ -1610,6 +1979,121 @@ func TestAccDedicatedServerInstallationResource(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"partitions should contain a root partition",
+		func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+						resource "leaseweb_dedicated_server_installation" "test" {
+							dedicated_server_id = "12345"
+						    operating_system_id = "UBUNTU_22_04_64BIT"
+						    partitions = [
+						    	{
+						    		filesystem = "ext4"
+						    		mountpoint = "/home"
+						    		size = "*"
+						    	}
+						    ]
+						}`,
+						ExpectError: regexp.MustCompile(
+							`The partitions must contain a partition with mountpoint "/"`,
+						),
+					},
+				},
+			})
+		},
+	)
+
+	t.Run(
+		"only one partition should use the remaining space",
+		func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+						resource "leaseweb_dedicated_server_installation" "test" {
+							dedicated_server_id = "12345"
+						    operating_system_id = "UBUNTU_22_04_64BIT"
+						    partitions = [
+						    	{
+						    		filesystem = "ext4"
+						    		mountpoint = "/"
+						    		size = "*"
+						    	},
+						    	{
+						    		filesystem = "ext4"
+						    		mountpoint = "/home"
+						    		size = "*"
+						    	}
+						    ]
+						}`,
+						ExpectError: regexp.MustCompile(
+							`Only one partition can have size "\*"`,
+						),
+					},
+				},
+			})
+		},
+	)
+
+	t.Run(
+		"raid.number_of_disks should be enough for raid.level",
+		func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+						resource "leaseweb_dedicated_server_installation" "test" {
+							dedicated_server_id = "12345"
+						    operating_system_id = "UBUNTU_22_04_64BIT"
+						    raid = {
+						    	level = 5
+						    	number_of_disks = 2
+						    	type = "SW"
+						    }
+						}`,
+						ExpectError: regexp.MustCompile(
+							`RAID level 5 requires at least 3 disks, got 2`,
+						),
+					},
+				},
+			})
+		},
+	)
+
+	t.Run(
+		"partitions.filesystem should be supported by the operating system",
+		func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+						resource "leaseweb_dedicated_server_installation" "test" {
+							dedicated_server_id = "12345"
+						    operating_system_id = "UBUNTU_22_04_64BIT"
+						    partitions = [
+						    	{
+						    		filesystem = "tralala"
+						    		mountpoint = "/"
+						    		size = "*"
+						    	}
+						    ]
+						}`,
+						ExpectError: regexp.MustCompile(
+							`Filesystem "tralala" is not supported by operating system`,
+						),
+					},
+				},
+			})
+		},
+	)
+
 	t.Run(
 		"ssh_keys should be set of string",
 		func(t *testing.T) {
@@ -1633,6 +2117,715 @@ func TestAccDedicatedServerInstallationResource(t *testing.T) {
 	)
 }
 
+func TestAccDedicatedServerJobDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "leaseweb_dedicated_server_job" "test" {
+					dedicated_server_id = "12345"
+					id = "bcf2bedf-8450-4b22-86a8-f30aeb3a38f9"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.leaseweb_dedicated_server_job.test",
+						"dedicated_server_id",
+						"12345",
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.leaseweb_dedicated_server_job.test",
+						"status",
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.leaseweb_dedicated_server_job.test",
+						"progress.percentage",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDedicatedServerRescueModeResource(t *testing.T) {
+	t.Run("launches rescue mode on a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_rescue_mode" "test" {
+						  dedicated_server_id = "12345"
+						  rescue_image_id     = "GRML"
+						  ssh_keys            = ["ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAACAQDA"]
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet(
+							"leaseweb_dedicated_server_rescue_mode.test",
+							"id",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_rescue_mode.test",
+							"power_cycle",
+							"true",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("rescue image id should be in the request", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_rescue_mode" "test" {
+						  dedicated_server_id = "12345"
+						}`,
+					ExpectError: regexp.MustCompile(
+						"The argument \"rescue_image_id\" is required, but no definition was found",
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerRescueImagesDataSource(t *testing.T) {
+	t.Run("get all rescue images", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_rescue_images" "test" {}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_rescue_images.test",
+							"rescue_images.#",
+							"3",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_rescue_images.test",
+							"rescue_images.0.id",
+							"GRML",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_rescue_images.test",
+							"rescue_images.0.name",
+							"GRML Linux Rescue Image (amd64)",
+						),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerHardwareDataSource(t *testing.T) {
+	t.Run("get the hardware of a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_hardware" "test" {
+						  dedicated_server_id = "12345"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_hardware.test",
+							"id",
+							"2378237",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_hardware.test",
+							"cpus.0.cores",
+							"4",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_hardware.test",
+							"disks.0.smart.overall_health",
+							"PASSED",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_hardware.test",
+							"memory.#",
+							"2",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_hardware.test",
+							"network_interfaces.0.logical_name",
+							"eth0",
+						),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerHardwareScanResource(t *testing.T) {
+	t.Run("runs a hardware scan on a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_hardware_scan" "test" {
+						  dedicated_server_id = "12345"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet(
+							"leaseweb_dedicated_server_hardware_scan.test",
+							"id",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_hardware_scan.test",
+							"power_cycle",
+							"true",
+						),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerIPMIResetResource(t *testing.T) {
+	t.Run("resets the IPMI interface of a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_ipmi_reset" "test" {
+						  dedicated_server_id = "12345"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet(
+							"leaseweb_dedicated_server_ipmi_reset.test",
+							"id",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_ipmi_reset.test",
+							"power_cycle",
+							"true",
+						),
+					),
+				},
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_ipmi_reset" "test" {
+						  dedicated_server_id = "12345"
+						  triggers = {
+						    firmware = "2.0"
+						  }
+						}`,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(
+								"leaseweb_dedicated_server_ipmi_reset.test",
+								plancheck.ResourceActionDestroyBeforeCreate,
+							),
+						},
+					},
+					Check: resource.TestCheckResourceAttr(
+						"leaseweb_dedicated_server_ipmi_reset.test",
+						"triggers.firmware",
+						"2.0",
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerNetworkInterfaceResource(t *testing.T) {
+	t.Run("opens a network interface of a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_network_interface" "test" {
+						  dedicated_server_id = "12345"
+						  type                = "PUBLIC"
+						  opened              = true
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_network_interface.test",
+							"opened",
+							"true",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_network_interface.test",
+							"link_speed",
+							"100Mbps",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_network_interface.test",
+							"status",
+							"OPEN",
+						),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:                         "leaseweb_dedicated_server_network_interface.test",
+					ImportStateId:                        "12345,PUBLIC",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "dedicated_server_id",
+				},
+				{
+					ResourceName:  "leaseweb_dedicated_server_network_interface.test",
+					ImportStateId: "12345,tralala",
+					ImportState:   true,
+					ExpectError: regexp.MustCompile(
+						"Unexpected Import Identifier",
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("type should be valid", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_network_interface" "test" {
+						  dedicated_server_id = "12345"
+						  type                = "tralala"
+						  opened              = true
+						}`,
+					ExpectError: regexp.MustCompile(
+						"Attribute type value must be one of",
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerDhcpReservationResource(t *testing.T) {
+	t.Run("creates a DHCP reservation for a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_dhcp_reservation" "test" {
+						  dedicated_server_id = "12345"
+						  bootfile            = "http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe"
+						  hostname            = "my-server"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_dhcp_reservation.test",
+							"bootfile",
+							"http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_dhcp_reservation.test",
+							"hostname",
+							"my-server",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_dhcp_reservation.test",
+							"lease.ip",
+							"192.168.0.100",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_dhcp_reservation.test",
+							"lease.mac",
+							"AA:BB:CC:DD:EE:FF",
+						),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:                         "leaseweb_dedicated_server_dhcp_reservation.test",
+					ImportStateId:                        "12345",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "dedicated_server_id",
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerBandwidthMetricsDataSource(t *testing.T) {
+	t.Run("retrieves the bandwidth metrics of a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_bandwidth_metrics" "test" {
+						  dedicated_server_id = "12345"
+						  from                = "2016-10-20T09:00:00Z"
+						  to                  = "2016-10-20T11:00:00Z"
+						  aggregation         = "AVG"
+						  granularity         = "HOUR"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_bandwidth_metrics.test",
+							"up_public.unit",
+							"bps",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_bandwidth_metrics.test",
+							"up_public.values.0.value",
+							"43212393",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_bandwidth_metrics.test",
+							"down_public.values.1.value",
+							"29900",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("aggregation should be valid", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_bandwidth_metrics" "test" {
+						  dedicated_server_id = "12345"
+						  from                = "2016-10-20T09:00:00Z"
+						  to                  = "2016-10-20T11:00:00Z"
+						  aggregation         = "SUM"
+						}`,
+					ExpectError: regexp.MustCompile(
+						"Attribute aggregation value must be one of",
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("from should be a RFC3339 date", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_bandwidth_metrics" "test" {
+						  dedicated_server_id = "12345"
+						  from                = "2016-10-20"
+						  to                  = "2016-10-20T11:00:00Z"
+						  aggregation         = "AVG"
+						}`,
+					ExpectError: regexp.MustCompile(
+						"must be specified using the RFC3339 format",
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerDatatrafficMetricsDataSource(t *testing.T) {
+	t.Run("retrieves the datatraffic metrics of a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_datatraffic_metrics" "test" {
+						  dedicated_server_id = "12345"
+						  from                = "2016-10-20T09:00:00Z"
+						  to                  = "2016-10-22T09:00:00Z"
+						  aggregation         = "SUM"
+						  granularity         = "DAY"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_datatraffic_metrics.test",
+							"up_public.unit",
+							"B",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_datatraffic_metrics.test",
+							"down_public.values.0.value",
+							"202499",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("granularity should be valid", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_datatraffic_metrics" "test" {
+						  dedicated_server_id = "12345"
+						  from                = "2016-10-20T09:00:00Z"
+						  to                  = "2016-10-22T09:00:00Z"
+						  aggregation         = "SUM"
+						  granularity         = "5MIN"
+						}`,
+					ExpectError: regexp.MustCompile(
+						"Attribute granularity value must be one of",
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerNotificationSettingsDataSource(t *testing.T) {
+	t.Run("lists the notification settings of a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_notification_settings" "test" {
+						  dedicated_server_id = "12345"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_notification_settings.test",
+							"bandwidth.0.id",
+							"12345",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_notification_settings.test",
+							"bandwidth.0.actions.0.last_triggered_at",
+							"2021-03-16T01:01:44+00:00",
+						),
+						resource.TestCheckResourceAttrSet(
+							"data.leaseweb_dedicated_server_notification_settings.test",
+							"datatraffic.0.id",
+						),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerPrivateNetworkResource(t *testing.T) {
+	t.Run("link_speed should be a supported port speed", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_private_network" "test" {
+						  dedicated_server_id = "12345"
+						  private_network_id  = "1238793"
+						  link_speed          = 500
+						}`,
+					ExpectError: regexp.MustCompile(
+						`Attribute link_speed value must be one of`,
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerIPResource(t *testing.T) {
+	t.Run("manages an IP of a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						resource "leaseweb_dedicated_server_ip" "test" {
+						  dedicated_server_id = "12345"
+						  ip                  = "12.123.123.1"
+						  reverse_lookup      = "domain.example.com"
+						  null_routed         = false
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_ip.test",
+							"cidr",
+							"12.123.123.1/24",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_ip.test",
+							"main_ip",
+							"true",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server_ip.test",
+							"network_type",
+							"PUBLIC",
+						),
+					),
+				},
+				// ImportState testing
+				{
+					ResourceName:                         "leaseweb_dedicated_server_ip.test",
+					ImportStateId:                        "12345,12.123.123.1",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "dedicated_server_id",
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerIPsDataSource(t *testing.T) {
+	t.Run("lists the IPs of a dedicated server", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_ips" "test" {
+						  dedicated_server_id = "12345"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_ips.test",
+							"ips.#",
+							"2",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_ips.test",
+							"ips.0.ip",
+							"12.123.123.1",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_ips.test",
+							"ips.0.null_routed",
+							"true",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_ips.test",
+							"ips.1.network_type",
+							"REMOTE_MANAGEMENT",
+						),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerOperatingSystemDataSource(t *testing.T) {
+	t.Run("retrieves the installation defaults of an operating system", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server_operating_system" "test" {
+						  operating_system_id = "CENTOS_7_64BIT"
+						  control_panel_id    = "PLESK_12"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_operating_system.test",
+							"name",
+							"CentOS 7 (x86_64)",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_operating_system.test",
+							"control_panel_supported",
+							"true",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_operating_system.test",
+							"defaults.device",
+							"SATA_SAS",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_operating_system.test",
+							"defaults.partitions.#",
+							"4",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_operating_system.test",
+							"defaults.partitions.3.mountpoint",
+							"/",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server_operating_system.test",
+							"supported_file_systems.#",
+							"5",
+						),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccDedicatedServerPartitionLayoutFunction(t *testing.T) {
+	t.Run("builds partitions from a specification", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					output "test" {
+						value = jsonencode(provider::leaseweb::partition_layout({
+							"/"     = "*"
+							"/boot" = "1G"
+							swap    = "4G"
+						}))
+					}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput(
+							"test",
+							`[{"filesystem":"ext2","mountpoint":"/boot","size":"1024"},{"filesystem":"swap","mountpoint":null,"size":"4096"},{"filesystem":"ext4","mountpoint":"/","size":"*"}]`,
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("root partition is required", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					output "test" {
+						value = provider::leaseweb::partition_layout({
+							"/boot" = "1G"
+						})
+					}`,
+					ExpectError: regexp.MustCompile(
+						`must contain a root \("/"\) partition`,
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccOperatingSystemsDataSource(t *testing.T) {
 	t.Run("get all operating systems", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
@@ -1713,7 +2906,8 @@ func TestAccDedicatedServerDataSource(t *testing.T) {
 				{
 					Config: providerConfig + `
 						data "leaseweb_dedicated_server" "test" {
-							id = "123456"
+							id                  = "123456"
+							include_power_state = true
 						}`,
 					Check: resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(
@@ -1726,6 +2920,26 @@ func TestAccDedicatedServerDataSource(t *testing.T) {
 							"contract_id",
 							"12123412312",
 						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server.test",
+							"powered_on",
+							"false",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server.test",
+							"contract.contract_term",
+							"36",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server.test",
+							"contract.sla",
+							"Bronze",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_server.test",
+							"contract.price_per_frequency",
+							"12.5",
+						),
 					),
 				},
 			},
@@ -1750,6 +2964,26 @@ func TestAccDedicatedServerDataSource(t *testing.T) {
 			})
 		},
 	)
+
+	t.Run("does not retrieve the power state by default", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_server" "test" {
+							id = "123456"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr(
+							"data.leaseweb_dedicated_server.test",
+							"powered_on",
+						),
+					),
+				},
+			},
+		})
+	})
 }
 
 func TestAccDedicatedServersDataSource(t *testing.T) {
@@ -1831,6 +3065,91 @@ func TestAccDedicatedServersDataSource(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("get full dedicated server objects", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				// Read testing
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_servers" "test" {
+							include_power_state = true
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_servers.test",
+							"servers.#",
+							"2",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_servers.test",
+							"servers.0.id",
+							"12345",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_servers.test",
+							"servers.0.reference",
+							"database.server",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_servers.test",
+							"servers.0.location_site",
+							"AMS-01",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_servers.test",
+							"servers.0.powered_on",
+							"false",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("filter dedicated servers client-side", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				// Read testing
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_servers" "test" {
+							reference_regex = "^web\\."
+							rack_type = "SHARED"
+						}`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_servers.test",
+							"ids.#",
+							"1",
+						),
+						resource.TestCheckResourceAttr(
+							"data.leaseweb_dedicated_servers.test",
+							"servers.0.id",
+							"47854",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("reference_regex should be a valid regular expression", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+						data "leaseweb_dedicated_servers" "test" {
+							reference_regex = "web("
+						}`,
+					ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+				},
+			},
+		})
+	})
 }
 
 func TestAccPublicCloudLoadBalancerResource(t *testing.T) {
@@ -2936,6 +4255,20 @@ func TestAccDedicatedServerResource(t *testing.T) {
 							"location.unit",
 							"12",
 						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"contract.id",
+							"12123412312",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"contract.starts_at",
+							"2024-04-01 00:00:00 +0000 UTC",
+						),
+						resource.TestCheckNoResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"contract.ends_at",
+						),
 					),
 				},
 			},
@@ -2943,6 +4276,179 @@ func TestAccDedicatedServerResource(t *testing.T) {
 		})
 	})
 
+	t.Run("powers off a server and waits until it is powered off", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					  resource "leaseweb_dedicated_server" "test" {
+					  }
+					  `,
+					ResourceName:       "leaseweb_dedicated_server.test",
+					ImportState:        true,
+					ImportStatePersist: true,
+					ImportStateId:      "123456",
+				},
+				{
+					Config: providerConfig + `
+					  resource "leaseweb_dedicated_server" "test" {
+					    powered_on = false
+					  }
+					  `,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"powered_on",
+							"false",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("leaves a server in a known state on destroy", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					  resource "leaseweb_dedicated_server" "test" {
+					  }
+					  `,
+					ResourceName:       "leaseweb_dedicated_server.test",
+					ImportState:        true,
+					ImportStatePersist: true,
+					ImportStateId:      "123456",
+				},
+				{
+					Config: providerConfig + `
+					  resource "leaseweb_dedicated_server" "test" {
+					    on_destroy = {
+					      reset_reference                = true
+					      power_off                      = true
+					      close_public_network_interface = true
+					      remove_dhcp_reservation        = true
+					      null_route_public_ip           = true
+					    }
+					  }
+					  `,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"on_destroy.power_off",
+							"true",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"on_destroy.null_route_public_ip",
+							"true",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("adopts an existing server by its ID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					  resource "leaseweb_dedicated_server" "test" {
+					    server_id = "12345"
+					  }
+					  `,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"id",
+							"12345",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"server_id",
+							"12345",
+						),
+					),
+				},
+				// Removing the lookup keeps the adopted server.
+				{
+					Config: providerConfig + `
+					  resource "leaseweb_dedicated_server" "test" {
+					  }
+					  `,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(
+								"leaseweb_dedicated_server.test",
+								plancheck.ResourceActionUpdate,
+							),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"id",
+							"12345",
+						),
+						resource.TestCheckNoResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"server_id",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("adopts an existing server by its asset ID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					  resource "leaseweb_dedicated_server" "test" {
+					    asset_id = "627294"
+					  }
+					  `,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet(
+							"leaseweb_dedicated_server.test",
+							"id",
+						),
+						resource.TestCheckResourceAttr(
+							"leaseweb_dedicated_server.test",
+							"asset_id",
+							"627294",
+						),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("server_id and asset_id cannot be combined", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					  resource "leaseweb_dedicated_server" "test" {
+					    server_id = "12345"
+					    asset_id  = "627294"
+					  }
+					  `,
+					ExpectError: regexp.MustCompile(
+						"Invalid Attribute Combination",
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("creating a new server causes an error", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -27,8 +28,15 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 )
 
+// allowedDesiredStates are the states an instance can be kept in.
+var allowedDesiredStates = []publiccloud.State{
+	publiccloud.STATE_RUNNING,
+	publiccloud.STATE_STOPPED,
+}
+
 type isoResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
@@ -63,21 +71,23 @@ func adaptContractToContractResource(contract publiccloud.InstanceContractDetail
 }
 
 type instanceResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Region              types.String `tfsdk:"region"`
-	Reference           types.String `tfsdk:"reference"`
-	Image               types.Object `tfsdk:"image"`
-	ISO                 types.Object `tfsdk:"iso"`
-	State               types.String `tfsdk:"state"`
-	Type                types.String `tfsdk:"type"`
-	RootDiskSize        types.Int32  `tfsdk:"root_disk_size"`
-	RootDiskStorageType types.String `tfsdk:"root_disk_storage_type"`
-	IPs                 types.List   `tfsdk:"ips"`
-	Contract            types.Object `tfsdk:"contract"`
-	MarketAppID         types.String `tfsdk:"market_app_id"`
-	HasPrivateNetwork   types.Bool   `tfsdk:"has_private_network"`
-	SshKey              types.String `tfsdk:"ssh_key"`
-	UserData            types.String `tfsdk:"user_data"`
+	ID                     types.String `tfsdk:"id"`
+	Region                 types.String `tfsdk:"region"`
+	Reference              types.String `tfsdk:"reference"`
+	Image                  types.Object `tfsdk:"image"`
+	ISO                    types.Object `tfsdk:"iso"`
+	State                  types.String `tfsdk:"state"`
+	DesiredState           types.String `tfsdk:"desired_state"`
+	Type                   types.String `tfsdk:"type"`
+	RootDiskSize           types.Int32  `tfsdk:"root_disk_size"`
+	RootDiskStorageType    types.String `tfsdk:"root_disk_storage_type"`
+	IPs                    types.List   `tfsdk:"ips"`
+	Contract               types.Object `tfsdk:"contract"`
+	MarketAppID            types.String `tfsdk:"market_app_id"`
+	HasPrivateNetwork      types.Bool   `tfsdk:"has_private_network"`
+	SshKey                 types.String `tfsdk:"ssh_key"`
+	UserData               types.String `tfsdk:"user_data"`
+	ReinstallOnImageChange types.Bool   `tfsdk:"reinstall_on_image_change"`
 }
 
 func adaptInstanceDetailsToInstanceResource(
@@ -95,6 +105,8 @@ func adaptInstanceDetailsToInstanceResource(
 		RootDiskStorageType: basetypes.NewStringValue(string(instanceDetails.GetRootDiskStorageType())),
 		MarketAppID:         basetypes.NewStringPointerValue(instanceDetails.MarketAppId.Get()),
 		HasPrivateNetwork:   basetypes.NewBoolValue(instanceDetails.GetHasPrivateNetwork()),
+		// Only known to Terraform, overwritten from the plan or state.
+		ReinstallOnImageChange: basetypes.NewBoolValue(false),
 	}
 
 	image := utils.AdaptSdkModelToResourceObject(
@@ -192,6 +204,9 @@ func NewInstanceResource() resource.Resource {
 
 type instanceResource struct {
 	utils.ResourceAPI
+	// pollInterval replaces the interval between the polls of waitUntil when
+	// set, so that tests do not have to wait.
+	pollInterval time.Duration
 }
 
 func (i *instanceResource) Create(
@@ -253,6 +268,8 @@ func (i *instanceResource) Create(
 	var instanceDetails *publiccloud.InstanceDetails
 	var res *http.Response
 
+	hasDesiredState := !plan.DesiredState.IsNull() && !plan.DesiredState.IsUnknown()
+
 	if !plan.HasPrivateNetwork.IsUnknown() && plan.HasPrivateNetwork.ValueBool() {
 
 		// If the instance is created with a private network, we need to wait for it to be running
@@ -276,6 +293,15 @@ func (i *instanceResource) Create(
 			return
 		}
 
+	} else if hasDesiredState {
+
+		// The instance can only be started or stopped once it is running
+		instanceDetails, res, err = i.waitUntilPropertyValueEquals(ctx, instance.GetId(), "state", string(publiccloud.STATE_RUNNING))
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, res)
+			return
+		}
+
 	} else {
 
 		instanceDetails, res, err = i.PubliccloudAPI.
@@ -288,6 +314,12 @@ func (i *instanceResource) Create(
 
 	}
 
+	res, err = i.applyDesiredState(ctx, plan.DesiredState, instanceDetails)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, res)
+		return
+	}
+
 	state := adaptInstanceDetailsToInstanceResource(
 		*instanceDetails,
 		ctx,
@@ -296,6 +328,8 @@ func (i *instanceResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.DesiredState = plan.DesiredState
+	state.ReinstallOnImageChange = plan.ReinstallOnImageChange
 
 	// Because get instance does not return either the SSH key or UserData
 	// we have to set them from config to avoid terraform from failing because of mismatched state
@@ -395,6 +429,10 @@ func (i *instanceResource) Read(
 	if !state.UserData.IsUnknown() {
 		newState.UserData = state.UserData
 	}
+	newState.DesiredState = state.DesiredState
+	if !state.ReinstallOnImageChange.IsNull() {
+		newState.ReinstallOnImageChange = state.ReinstallOnImageChange
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
@@ -445,15 +483,147 @@ func (i *instanceResource) TogglePrivateNetwork(
 
 }
 
+// reinstall recreates the instance with the image and waits until it is
+// running again. The instance keeps its ID, IPs and contract.
+func (i *instanceResource) reinstall(
+	ctx context.Context,
+	imageID string,
+	plan instanceResourceModel,
+	instanceDetails *publiccloud.InstanceDetails,
+) (*http.Response, error) {
+	opts := publiccloud.NewReinstallResourceOpts(imageID)
+	if !plan.MarketAppID.IsUnknown() {
+		opts.MarketAppId = plan.MarketAppID.ValueStringPointer()
+	}
+
+	res, err := i.PubliccloudAPI.ReinstallInstance(ctx, instanceDetails.Id).
+		ReinstallResourceOpts(*opts).
+		Execute()
+	if err != nil {
+		return res, err
+	}
+
+	// The instance is still RUNNING right after the request, and its image
+	// may already have been updated, so first wait until the reinstall has
+	// actually started.
+	_, res, err = i.waitUntil(
+		ctx,
+		instanceDetails.Id,
+		func(instanceDetails *publiccloud.InstanceDetails) bool {
+			return instanceDetails.GetState() != publiccloud.STATE_RUNNING
+		},
+	)
+	if err != nil {
+		return res, err
+	}
+
+	updated, res, err := i.waitUntil(
+		ctx,
+		instanceDetails.Id,
+		func(instanceDetails *publiccloud.InstanceDetails) bool {
+			image := instanceDetails.GetImage()
+			return instanceDetails.GetState() == publiccloud.STATE_RUNNING &&
+				image.GetId() == imageID
+		},
+	)
+	if err != nil {
+		return res, err
+	}
+
+	*instanceDetails = *updated
+
+	return nil, nil
+}
+
+// applyDesiredState starts or stops the instance until its state matches
+// desiredState. Nothing happens when no desired state is set.
+func (i *instanceResource) applyDesiredState(
+	ctx context.Context,
+	desiredState types.String,
+	instanceDetails *publiccloud.InstanceDetails,
+) (*http.Response, error) {
+	if desiredState.IsNull() || desiredState.IsUnknown() {
+		return nil, nil
+	}
+
+	desired := publiccloud.State(desiredState.ValueString())
+	current := instanceDetails.GetState()
+	if current == desired {
+		return nil, nil
+	}
+
+	switch desired {
+	case publiccloud.STATE_RUNNING:
+		if current != publiccloud.STATE_STARTING {
+			res, err := i.PubliccloudAPI.StartInstance(ctx, instanceDetails.Id).Execute()
+			if err != nil {
+				return res, err
+			}
+		}
+	case publiccloud.STATE_STOPPED:
+		if current != publiccloud.STATE_STOPPING {
+			res, err := i.PubliccloudAPI.StopInstance(ctx, instanceDetails.Id).Execute()
+			if err != nil {
+				return res, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported desired state: %s", desired)
+	}
+
+	updated, res, err := i.waitUntilPropertyValueEquals(ctx, instanceDetails.Id, "state", string(desired))
+	if err != nil {
+		return res, err
+	}
+
+	*instanceDetails = *updated
+
+	return nil, nil
+}
+
 func (i *instanceResource) waitUntilPropertyValueEquals(
 	ctx context.Context,
 	instanceId string,
 	propertyName string,
 	expectedValue any,
 ) (*publiccloud.InstanceDetails, *http.Response, error) {
+	switch propertyName {
+	case "has_private_network", "state":
+	default:
+		return nil, nil, fmt.Errorf("unsupported property name: %s", propertyName)
+	}
 
-	// Create a constant backoff with a 5-second retry interval
-	bo := backoff.NewConstantBackOff(10 * time.Second)
+	return i.waitUntil(
+		ctx,
+		instanceId,
+		func(instanceDetails *publiccloud.InstanceDetails) bool {
+			var currentValue any
+
+			switch propertyName {
+			case "has_private_network":
+				currentValue = instanceDetails.GetHasPrivateNetwork()
+			case "state":
+				currentValue = string(instanceDetails.GetState())
+			}
+
+			return currentValue == expectedValue
+		},
+	)
+}
+
+// waitUntil polls the instance until done returns true for its details.
+func (i *instanceResource) waitUntil(
+	ctx context.Context,
+	instanceId string,
+	done func(instanceDetails *publiccloud.InstanceDetails) bool,
+) (*publiccloud.InstanceDetails, *http.Response, error) {
+
+	// Create a constant backoff with a 10-second retry interval
+	interval := 10 * time.Second
+	if i.pollInterval != 0 {
+		interval = i.pollInterval
+	}
+	bo := backoff.NewConstantBackOff(interval)
 
 	// Set the retry limit to 30 retries (5 minutes)
 	retryCount := 0
@@ -472,18 +642,7 @@ func (i *instanceResource) waitUntilPropertyValueEquals(
 			return nil, httpResponse, err
 		}
 
-		var currentValue any
-
-		switch propertyName {
-		case "has_private_network":
-			currentValue = instanceDetails.GetHasPrivateNetwork()
-		case "state":
-			currentValue = string(instanceDetails.GetState())
-		default:
-			return nil, nil, fmt.Errorf("unsupported property name: %s", propertyName)
-		}
-
-		if currentValue == expectedValue {
+		if done(instanceDetails) {
 			return instanceDetails, httpResponse, nil
 		}
 
@@ -540,6 +699,29 @@ func (i *instanceResource) Update(
 		return
 	}
 
+	// A changed image only reaches Update when reinstall_on_image_change is set,
+	// otherwise the instance is replaced.
+	var currentImageID types.String
+	resp.Diagnostics.Append(
+		req.State.GetAttribute(ctx, path.Root("image").AtName("id"), &currentImageID)...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	image := imageResourceModel{}
+	diags = plan.Image.As(ctx, &image, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if !image.ID.Equal(currentImageID) {
+		res, err := i.reinstall(ctx, image.ID.ValueString(), plan, instanceDetails)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, res)
+			return
+		}
+	}
+
 	if !plan.HasPrivateNetwork.IsUnknown() {
 		res, err := i.TogglePrivateNetwork(plan, instanceDetails, ctx)
 		if err != nil {
@@ -549,6 +731,12 @@ func (i *instanceResource) Update(
 
 	}
 
+	res, err := i.applyDesiredState(ctx, plan.DesiredState, instanceDetails)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, res)
+		return
+	}
+
 	state := adaptInstanceDetailsToInstanceResource(
 		*instanceDetails,
 		ctx,
@@ -557,10 +745,41 @@ func (i *instanceResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.DesiredState = plan.DesiredState
+	state.ReinstallOnImageChange = plan.ReinstallOnImageChange
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
+// ModifyPlan plans the desired state as the instance's state, so an update is
+// planned when the instance has been started or stopped outside of Terraform.
+func (i *instanceResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan instanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DesiredState.IsNull() || plan.DesiredState.IsUnknown() {
+		return
+	}
+
+	if plan.State.IsUnknown() || plan.State.ValueString() != plan.DesiredState.ValueString() {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("state"), plan.DesiredState)...,
+		)
+	}
+}
+
 func (i *instanceResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -575,6 +794,21 @@ func (i *instanceResource) Schema(
 	)
 	contractTerms := utils.NewIntMarkdownList(publiccloud.AllowedContractTermEnumValues)
 	warningError := "**WARNING!** Changing this value once running will cause this instance to be destroyed and a new one to be created."
+	requiresReplaceUnlessReinstall := stringplanmodifier.RequiresReplaceIf(
+		func(
+			ctx context.Context,
+			req planmodifier.StringRequest,
+			resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			var reinstall types.Bool
+			resp.Diagnostics.Append(
+				req.Plan.GetAttribute(ctx, path.Root("reinstall_on_image_change"), &reinstall)...,
+			)
+			resp.RequiresReplace = !reinstall.ValueBool()
+		},
+		"Changing the image requires replacement unless reinstall_on_image_change is set.",
+		"Changing the image requires replacement unless `reinstall_on_image_change` is set.",
+	)
 
 	resp.Schema = schema.Schema{
 		Description: utils.BetaDescription,
@@ -610,9 +844,9 @@ func (i *instanceResource) Schema(
 				Attributes: map[string]schema.Attribute{
 					"id": schema.StringAttribute{
 						Required:    true,
-						Description: "Can be either an Operating System or a UUID in case of a Custom Image ID. " + warningError,
+						Description: "Can be either an Operating System or a UUID in case of a Custom Image ID. " + warningError + " Set `reinstall_on_image_change` to reinstall the instance instead.",
 						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.RequiresReplace(),
+							requiresReplaceUnlessReinstall,
 						},
 					},
 					"instance_id": schema.StringAttribute{
@@ -661,6 +895,16 @@ func (i *instanceResource) Schema(
 				Computed:    true,
 				Description: "The instance's current state",
 			},
+			"desired_state": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"The state the instance should be kept in. The instance is started or stopped when its `state` differs. Valid options are %s",
+					utils.StringTypeArrayToMarkdown(allowedDesiredStates),
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(allowedDesiredStates)...),
+				},
+			},
 			"type": schema.StringAttribute{
 				Required: true,
 				Description: fmt.Sprintf(
@@ -763,6 +1007,12 @@ func (i *instanceResource) Schema(
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"reinstall_on_image_change": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If true, changing `image.id` reinstalls the instance with the new image instead of replacing it. The instance keeps its ID, IPs and contract, but all data on the instance is lost. `ssh_key` and `user_data` are not applied again on reinstall, even though they remain in the state. Defaults to `false`",
+			},
 			"user_data": schema.StringAttribute{
 				Optional:    true,
 				Description: "User data to be installed into the instance. Cannot be used if ssh_key is provided.",
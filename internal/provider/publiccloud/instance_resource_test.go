@@ -2,12 +2,16 @@ package publiccloud
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -103,3 +107,211 @@ func Test_adaptInstanceDetailsToInstanceResource(t *testing.T) {
 
 	assert.True(t, got.HasPrivateNetwork.ValueBool())
 }
+
+// instanceAPI fakes the instance endpoints of the public cloud API. Every
+// GetInstance call returns the next of the instances, the last one is
+// repeated.
+type instanceAPI struct {
+	publiccloud.PubliccloudAPI
+	instances    []publiccloud.InstanceDetails
+	getCalls     int
+	reinstallErr error
+	calls        []string
+}
+
+func (i *instanceAPI) GetInstance(_ context.Context, _ string) publiccloud.ApiGetInstanceRequest {
+	return publiccloud.ApiGetInstanceRequest{ApiService: i}
+}
+
+func (i *instanceAPI) GetInstanceExecute(_ publiccloud.ApiGetInstanceRequest) (*publiccloud.InstanceDetails, *http.Response, error) {
+	instance := i.instances[min(i.getCalls, len(i.instances)-1)]
+	i.getCalls++
+
+	return &instance, nil, nil
+}
+
+func (i *instanceAPI) ReinstallInstance(_ context.Context, _ string) publiccloud.ApiReinstallInstanceRequest {
+	return publiccloud.ApiReinstallInstanceRequest{ApiService: i}
+}
+
+func (i *instanceAPI) ReinstallInstanceExecute(_ publiccloud.ApiReinstallInstanceRequest) (*http.Response, error) {
+	i.calls = append(i.calls, "reinstall")
+	if i.reinstallErr != nil {
+		return &http.Response{StatusCode: http.StatusBadRequest}, i.reinstallErr
+	}
+
+	return nil, nil
+}
+
+func (i *instanceAPI) StartInstance(_ context.Context, _ string) publiccloud.ApiStartInstanceRequest {
+	return publiccloud.ApiStartInstanceRequest{ApiService: i}
+}
+
+func (i *instanceAPI) StartInstanceExecute(_ publiccloud.ApiStartInstanceRequest) (*http.Response, error) {
+	i.calls = append(i.calls, "start")
+
+	return nil, nil
+}
+
+func (i *instanceAPI) StopInstance(_ context.Context, _ string) publiccloud.ApiStopInstanceRequest {
+	return publiccloud.ApiStopInstanceRequest{ApiService: i}
+}
+
+func (i *instanceAPI) StopInstanceExecute(_ publiccloud.ApiStopInstanceRequest) (*http.Response, error) {
+	i.calls = append(i.calls, "stop")
+
+	return nil, nil
+}
+
+func newInstanceDetails(state publiccloud.State, imageID string) publiccloud.InstanceDetails {
+	return publiccloud.InstanceDetails{
+		Id:    "id",
+		State: state,
+		Image: publiccloud.Image{Id: imageID},
+	}
+}
+
+func Test_instanceResource_reinstall(t *testing.T) {
+	plan := instanceResourceModel{MarketAppID: types.StringNull()}
+
+	t.Run("waits until the reinstall has started and finished", func(t *testing.T) {
+		api := &instanceAPI{
+			instances: []publiccloud.InstanceDetails{
+				// The image is updated before the instance leaves RUNNING.
+				newInstanceDetails(publiccloud.STATE_RUNNING, "UBUNTU_24_04_64BIT"),
+				newInstanceDetails(publiccloud.STATE_CREATING, "UBUNTU_24_04_64BIT"),
+				newInstanceDetails(publiccloud.STATE_RUNNING, "UBUNTU_24_04_64BIT"),
+			},
+		}
+		i := instanceResource{
+			ResourceAPI:  utils.ResourceAPI{PubliccloudAPI: api},
+			pollInterval: time.Millisecond,
+		}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_RUNNING, "UBUNTU_22_04_64BIT")
+
+		_, err := i.reinstall(context.TODO(), "UBUNTU_24_04_64BIT", plan, &instanceDetails)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"reinstall"}, api.calls)
+		assert.Equal(t, 3, api.getCalls)
+		assert.Equal(t, publiccloud.STATE_RUNNING, instanceDetails.GetState())
+		assert.Equal(t, "UBUNTU_24_04_64BIT", instanceDetails.Image.GetId())
+	})
+
+	t.Run("waits until the new image is reported", func(t *testing.T) {
+		api := &instanceAPI{
+			instances: []publiccloud.InstanceDetails{
+				newInstanceDetails(publiccloud.STATE_CREATING, "UBUNTU_22_04_64BIT"),
+				newInstanceDetails(publiccloud.STATE_RUNNING, "UBUNTU_22_04_64BIT"),
+				newInstanceDetails(publiccloud.STATE_RUNNING, "UBUNTU_24_04_64BIT"),
+			},
+		}
+		i := instanceResource{
+			ResourceAPI:  utils.ResourceAPI{PubliccloudAPI: api},
+			pollInterval: time.Millisecond,
+		}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_RUNNING, "UBUNTU_22_04_64BIT")
+
+		_, err := i.reinstall(context.TODO(), "UBUNTU_24_04_64BIT", plan, &instanceDetails)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, api.getCalls)
+		assert.Equal(t, "UBUNTU_24_04_64BIT", instanceDetails.Image.GetId())
+	})
+
+	t.Run("returns the error of the reinstall request", func(t *testing.T) {
+		api := &instanceAPI{reinstallErr: errors.New("bad request")}
+		i := instanceResource{ResourceAPI: utils.ResourceAPI{PubliccloudAPI: api}}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_RUNNING, "UBUNTU_22_04_64BIT")
+
+		response, err := i.reinstall(context.TODO(), "UBUNTU_24_04_64BIT", plan, &instanceDetails)
+
+		assert.EqualError(t, err, "bad request")
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		assert.Equal(t, 0, api.getCalls)
+	})
+}
+
+func Test_instanceResource_applyDesiredState(t *testing.T) {
+	t.Run("does nothing without a desired state", func(t *testing.T) {
+		api := &instanceAPI{}
+		i := instanceResource{ResourceAPI: utils.ResourceAPI{PubliccloudAPI: api}}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_RUNNING, "")
+
+		_, err := i.applyDesiredState(context.TODO(), types.StringNull(), &instanceDetails)
+
+		assert.NoError(t, err)
+		assert.Empty(t, api.calls)
+		assert.Equal(t, 0, api.getCalls)
+	})
+
+	t.Run("does nothing if the instance is in the desired state", func(t *testing.T) {
+		api := &instanceAPI{}
+		i := instanceResource{ResourceAPI: utils.ResourceAPI{PubliccloudAPI: api}}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_STOPPED, "")
+
+		_, err := i.applyDesiredState(context.TODO(), types.StringValue("STOPPED"), &instanceDetails)
+
+		assert.NoError(t, err)
+		assert.Empty(t, api.calls)
+	})
+
+	t.Run("starts a stopped instance and waits until it runs", func(t *testing.T) {
+		api := &instanceAPI{
+			instances: []publiccloud.InstanceDetails{
+				newInstanceDetails(publiccloud.STATE_STARTING, ""),
+				newInstanceDetails(publiccloud.STATE_RUNNING, ""),
+			},
+		}
+		i := instanceResource{
+			ResourceAPI:  utils.ResourceAPI{PubliccloudAPI: api},
+			pollInterval: time.Millisecond,
+		}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_STOPPED, "")
+
+		_, err := i.applyDesiredState(context.TODO(), types.StringValue("RUNNING"), &instanceDetails)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"start"}, api.calls)
+		assert.Equal(t, publiccloud.STATE_RUNNING, instanceDetails.GetState())
+	})
+
+	t.Run("stops a running instance and waits until it has stopped", func(t *testing.T) {
+		api := &instanceAPI{
+			instances: []publiccloud.InstanceDetails{newInstanceDetails(publiccloud.STATE_STOPPED, "")},
+		}
+		i := instanceResource{ResourceAPI: utils.ResourceAPI{PubliccloudAPI: api}}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_RUNNING, "")
+
+		_, err := i.applyDesiredState(context.TODO(), types.StringValue("STOPPED"), &instanceDetails)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"stop"}, api.calls)
+		assert.Equal(t, publiccloud.STATE_STOPPED, instanceDetails.GetState())
+	})
+
+	t.Run("only waits for an instance that is already starting", func(t *testing.T) {
+		api := &instanceAPI{
+			instances: []publiccloud.InstanceDetails{newInstanceDetails(publiccloud.STATE_RUNNING, "")},
+		}
+		i := instanceResource{ResourceAPI: utils.ResourceAPI{PubliccloudAPI: api}}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_STARTING, "")
+
+		_, err := i.applyDesiredState(context.TODO(), types.StringValue("RUNNING"), &instanceDetails)
+
+		assert.NoError(t, err)
+		assert.Empty(t, api.calls)
+		assert.Equal(t, 1, api.getCalls)
+	})
+
+	t.Run("returns an error for an unsupported desired state", func(t *testing.T) {
+		api := &instanceAPI{}
+		i := instanceResource{ResourceAPI: utils.ResourceAPI{PubliccloudAPI: api}}
+		instanceDetails := newInstanceDetails(publiccloud.STATE_RUNNING, "")
+
+		_, err := i.applyDesiredState(context.TODO(), types.StringValue("DESTROYED"), &instanceDetails)
+
+		assert.EqualError(t, err, "unsupported desired state: DESTROYED")
+		assert.Empty(t, api.calls)
+	})
+}
@@ -1,5 +1,7 @@
 package utils
 
+import "net/http"
+
 func NewOffset(limit, offset, totalCount int32) *int32 {
 	newOffset := offset + limit
 	if newOffset >= totalCount {
@@ -8,3 +10,40 @@ func NewOffset(limit, offset, totalCount int32) *int32 {
 
 	return &newOffset
 }
+
+// Page is a single page of a paginated API list.
+type Page[T any] struct {
+	Items      []T
+	Limit      int32
+	Offset     int32
+	TotalCount int32
+}
+
+// GetAllPages calls fetchPage with an increasing offset until every item of
+// the list has been retrieved. Paging stops when the API does not return the
+// requested page, so a misbehaving API cannot keep us paging forever.
+func GetAllPages[T any](
+	fetchPage func(offset int32) (*Page[T], *http.Response, error),
+) ([]T, *http.Response, error) {
+	var items []T
+	var offset int32
+
+	for {
+		page, response, err := fetchPage(offset)
+		if err != nil {
+			return nil, response, err
+		}
+
+		items = append(items, page.Items...)
+
+		if page.Offset != offset || page.Limit <= 0 || len(page.Items) == 0 {
+			return items, nil, nil
+		}
+
+		nextOffset := NewOffset(page.Limit, offset, page.TotalCount)
+		if nextOffset == nil {
+			return items, nil, nil
+		}
+		offset = *nextOffset
+	}
+}
@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,6 +38,70 @@ func TestNewOffset(t *testing.T) {
 	)
 }
 
+func TestGetAllPages(t *testing.T) {
+	t.Run("retrieves every page", func(t *testing.T) {
+		var offsets []int32
+
+		got, _, err := GetAllPages(func(offset int32) (*Page[int32], *http.Response, error) {
+			offsets = append(offsets, offset)
+			items := []int32{offset, offset + 1}
+			if offset == 4 {
+				items = items[:1]
+			}
+
+			return &Page[int32]{Items: items, Limit: 2, Offset: offset, TotalCount: 5}, nil, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{0, 1, 2, 3, 4}, got)
+		assert.Equal(t, []int32{0, 2, 4}, offsets)
+	})
+
+	t.Run("stops when the requested page is not returned", func(t *testing.T) {
+		calls := 0
+
+		got, _, err := GetAllPages(func(_ int32) (*Page[string], *http.Response, error) {
+			calls++
+
+			return &Page[string]{Items: []string{"item"}, Limit: 2, Offset: 80, TotalCount: 132}, nil, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"item"}, got)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops when a page is empty", func(t *testing.T) {
+		calls := 0
+
+		got, _, err := GetAllPages(func(offset int32) (*Page[string], *http.Response, error) {
+			calls++
+
+			return &Page[string]{Limit: 2, Offset: offset, TotalCount: 10}, nil, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("returns the error and response of a failed page", func(t *testing.T) {
+		response := &http.Response{StatusCode: http.StatusInternalServerError}
+
+		got, gotResponse, err := GetAllPages(func(offset int32) (*Page[string], *http.Response, error) {
+			if offset == 0 {
+				return &Page[string]{Items: []string{"item"}, Limit: 1, Offset: 0, TotalCount: 2}, nil, nil
+			}
+
+			return nil, response, errors.New("internal server error")
+		})
+
+		assert.Nil(t, got)
+		assert.Same(t, response, gotResponse)
+		assert.EqualError(t, err, "internal server error")
+	})
+}
+
 func ExampleNewOffset() {
 	offset := NewOffset(0, 5, 12)
 	fmt.Println(*offset)